@@ -33,6 +33,7 @@ import (
 	tmOs "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 
 	"github.com/dfinance/dnode/cmd/config"
 	"github.com/dfinance/dnode/cmd/config/restrictions"
@@ -51,6 +52,7 @@ import (
 	"github.com/dfinance/dnode/x/poa"
 	"github.com/dfinance/dnode/x/vm"
 	"github.com/dfinance/dnode/x/vmauth"
+	"github.com/dfinance/dnode/x/vmsupply"
 )
 
 const (
@@ -122,7 +124,7 @@ type DnServiceApp struct {
 	ccsKeeper       ccstorage.Keeper
 	accountKeeper   vmauth.Keeper
 	bankKeeper      bank.Keeper
-	supplyKeeper    supply.Keeper
+	supplyKeeper    vmsupply.Keeper
 	stakingKeeper   staking.Keeper
 	mintKeeper      mint.Keeper
 	distrKeeper     distribution.Keeper
@@ -149,11 +151,26 @@ type DnServiceApp struct {
 }
 
 // Initialize connection to VM server.
-func (app *DnServiceApp) InitializeVMConnection(addr string) {
+// Connection is re-established automatically using VM config retry backoff settings.
+func (app *DnServiceApp) InitializeVMConnection(config *config.VMConfig) {
 	var err error
 
+	addr := config.Address
+	connParams := grpc.ConnectParams{
+		Backoff: backoff.Config{
+			BaseDelay:  time.Duration(config.RetryInitialBackoffInMs) * time.Millisecond,
+			Multiplier: config.RetryBackoffMultiplier,
+			Jitter:     backoff.DefaultConfig.Jitter,
+			MaxDelay:   time.Duration(config.RetryMaxBackoffInMs) * time.Millisecond,
+		},
+		MinConnectTimeout: time.Duration(config.HealthCheckTimeoutInMs) * time.Millisecond,
+	}
+	if connParams.Backoff.BaseDelay == 0 || connParams.Backoff.Multiplier < 1.0 || connParams.Backoff.MaxDelay == 0 {
+		connParams.Backoff = backoff.DefaultConfig
+	}
+
 	app.Logger().Info(fmt.Sprintf("Creating connection to VM, address: %s", addr))
-	app.vmConn, err = helpers.GetGRpcClientConnection(addr, 1*time.Second)
+	app.vmConn, err = helpers.GetGRpcClientConnection(addr, 1*time.Second, grpc.WithConnectParams(connParams))
 	if err != nil {
 		panic(err)
 	}
@@ -222,6 +239,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	tkeys := sdk.NewTransientStoreKeys(
 		params.TStoreKey,
 		staking.TStoreKey,
+		vm.TStoreKey,
 	)
 
 	var app = &DnServiceApp{
@@ -235,7 +253,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 
 	// initialize connections
 	app.InitializeVMDataServer(config.DataListen)
-	app.InitializeVMConnection(config.Address)
+	app.InitializeVMConnection(config)
 
 	// Reduce ConsensusPower reduction coefficient (1 xfi == 1 power unit)
 	// 1 xfi == 1000000000000000000
@@ -274,11 +292,22 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.vmKeeper = vm.NewKeeper(
 		cdc,
 		keys[vm.StoreKey],
+		tkeys[vm.TStoreKey],
+		app.paramsKeeper.Subspace(vm.DefaultParamspace),
 		app.vmConn,
 		app.vmListener,
 		config,
+		[]vm.KeeperOption{
+			// middlewares are registered before dependant keepers are created (pointers are used)
+			vm.WithDSDataMiddlewares(
+				oracle.NewPriceInfoDSMiddleware(&app.oracleKeeper),
+				ccstorage.NewCurrencyInfoDSMiddleware(&app.ccsKeeper),
+				ccstorage.NewFrozenInfoDSMiddleware(&app.ccsKeeper),
+			),
+		},
 		ccstorage.RequestVMStoragePerms(),
 		oracle.RequestVMStoragePerms(),
+		core.RequestVMPerms(),
 		appModulePerms(vm.AvailablePermissions),
 	)
 
@@ -289,7 +318,9 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		app.vmKeeper,
 		currencies.RequestCCStoragePerms(),
 		vmauth.RequestCCStoragePerms(),
+		vmsupply.RequestCCStoragePerms(),
 		markets.RequestCCStoragePerms(),
+		core.RequestCCStoragePerms(),
 		appModulePerms(ccstorage.AvailablePermissions),
 	)
 
@@ -310,12 +341,16 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	)
 
 	// SupplyKeeper collects transaction fees and renders them to the fee distribution module.
-	app.supplyKeeper = supply.NewKeeper(
-		cdc,
-		keys[supply.StoreKey],
-		app.accountKeeper,
-		app.bankKeeper,
-		maccPerms,
+	// VMSupply keeper wraps it and keeps ccstorage / VM currencies supplies updated.
+	app.supplyKeeper = vmsupply.NewKeeper(
+		supply.NewKeeper(
+			cdc,
+			keys[supply.StoreKey],
+			app.accountKeeper,
+			app.bankKeeper,
+			maccPerms,
+		),
+		app.ccsKeeper,
 	)
 
 	// StakingKeeper stores Proof-of-Stake validators info and staking values.
@@ -379,6 +414,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.ccKeeper = currencies.NewKeeper(
 		cdc,
 		keys[currencies.StoreKey],
+		app.accountKeeper,
 		app.bankKeeper,
 		app.supplyKeeper,
 		app.ccsKeeper,
@@ -430,7 +466,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		cdc,
 		keys[orders.StoreKey],
 		app.bankKeeper,
-		app.supplyKeeper,
+		app.supplyKeeper.Keeper,
 		app.marketKeeper,
 		orderbook.RequestOrdersPerms(),
 		appModulePerms(orders.AvailablePermissions),
@@ -479,7 +515,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		ccstorage.NewAppModule(app.ccsKeeper),
 		vmauth.NewAppModule(app.accountKeeper),
 		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
-		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
+		supply.NewAppModule(app.supplyKeeper.Keeper, app.accountKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		mint.NewAppModule(app.mintKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
@@ -499,12 +535,12 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 	app.mm.SetOrderBeginBlockers(
 		upgrade.ModuleName,
 		mint.ModuleName,
-		currencies.ModuleName, // Must go after mint.
 		distribution.ModuleName,
 		slashing.ModuleName,
 		vm.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
+		currencies.ModuleName, // Must go before crisis (VM token currencies supply sync).
 		crisis.ModuleName,
 		gov.ModuleName,
 		staking.ModuleName,
@@ -512,6 +548,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		oracle.ModuleName,
 		orders.ModuleName,
 		orderbook.ModuleName,
+		vm.ModuleName, // VM writeSets indexer flush.
 	)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
@@ -554,6 +591,8 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		core.NewAnteHandler(
 			app.accountKeeper,
 			app.supplyKeeper,
+			app.ccsKeeper,
+			app.vmKeeper,
 			auth.DefaultSigVerificationGasConsumer,
 		),
 	)
@@ -566,12 +605,11 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		tmOs.Exit(err.Error())
 	}
 
-	// Temporary solution, but seems works.
-	// Set context for reading data from DS store.
-	// TODO: find another way for storage to read data.
+	// Set DS server default context (latest committed state) used till the first BeginBlock.
 	dsContext := app.GetDSContext()
 	app.vmKeeper.SetDSContext(dsContext)
 	app.vmKeeper.StartDSServer(dsContext)
+	app.vmKeeper.StartConnMonitor(dsContext)
 	time.Sleep(1 * time.Second) // need for DS to initialize stdlib, will be removed later.
 
 	return app
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/dfinance/dnode/x/vmauth"
+)
+
+// AuditVMBalances returns all accounts with auth coins and VM balance resources mismatch for the current height.
+func (app *DnServiceApp) AuditVMBalances() (vmauth.BalanceMismatches, error) {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	return app.accountKeeper.GetBalanceMismatches(ctx)
+}
+
+// ExportAppStateWithFixedVMBalances fixes all balance mismatches and exports genesis and validators.
+// {useVMCoins} defines the source of truth: VM balance resources or auth coins.
+// State is not committed, fix is only applied to the exported genesis.
+func (app *DnServiceApp) ExportAppStateWithFixedVMBalances(useVMCoins bool,
+) (appState json.RawMessage, validators []tmTypes.GenesisValidator, mismatches vmauth.BalanceMismatches, retErr error) {
+
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	mismatches, err := app.accountKeeper.GetBalanceMismatches(ctx)
+	if err != nil {
+		retErr = fmt.Errorf("auditing balances: %w", err)
+		return
+	}
+
+	for _, mismatch := range mismatches {
+		if err := app.accountKeeper.FixBalanceMismatch(ctx, app.supplyKeeper, mismatch, useVMCoins); err != nil {
+			retErr = fmt.Errorf("fixing balances: %w", err)
+			return
+		}
+	}
+
+	genState := app.mm.ExportGenesis(ctx)
+	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
+	if err != nil {
+		retErr = fmt.Errorf("genState JSON marshal: %w", err)
+		return
+	}
+
+	validators = staking.WriteValidators(ctx, app.stakingKeeper)
+
+	return
+}
@@ -18,9 +18,17 @@ import (
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/multisig"
 	"github.com/dfinance/dnode/x/orderbook"
+	"github.com/dfinance/dnode/x/vm"
 	"github.com/dfinance/dnode/x/vmauth"
 )
 
+// ExportVMWriteSet exports VM writeSets filtered by addresses / path prefixes (VM genesis state format).
+func (app *DnServiceApp) ExportVMWriteSet(filter vm.WriteSetFilter) vm.GenesisState {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	return app.vmKeeper.ExportWriteSet(ctx, filter)
+}
+
 // Exports genesis and validators.
 func (app *DnServiceApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string,
 ) (appState json.RawMessage, validators []tmTypes.GenesisValidator, retErr error) {
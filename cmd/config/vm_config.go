@@ -18,10 +18,29 @@ const (
 	DefaultDataListen   = "tcp://127.0.0.1:50052" // Default data server address to listen for connections from VM.
 	DefaultCompilerAddr = DefaultVMAddress
 
+	// Default DS server read cache size (number of entries).
+	DefaultDSCacheSize = 10000
+
+	// Default VM writeSets / events indexer DB directories (relative to the home directory).
+	DefaultWriteSetIndexDir = "data"
+	DefaultEventIndexDir    = "data"
+
 	// Default retry configs.
 	DefaultMaxAttempts = 0 // Default maximum attempts for retry.
 	DefaultReqTimeout  = 0 // Default request timeout per attempt [ms].
 
+	// Default retry / reconnect backoff configs.
+	DefaultRetryInitialBackoff    = 100  // Default initial backoff [ms].
+	DefaultRetryMaxBackoff        = 5000 // Default max backoff [ms].
+	DefaultRetryBackoffMultiplier = 1.6  // Default backoff multiplier.
+
+	// Default non-consensus (queries, health checks) request timeouts.
+	DefaultQueryReqTimeout = 5000 // Default query request timeout [ms].
+
+	// Default VM connection health check configs.
+	DefaultHealthCheckPeriod  = 1000 // Default health check period [ms].
+	DefaultHealthCheckTimeout = 500  // Default health check request timeout [ms].
+
 	// Invariants check period for crisis module (in blocks)
 	DefInvCheckPeriod = 10
 )
@@ -34,15 +53,48 @@ type VMConfig struct {
 	// Retry policy
 	MaxAttempts    uint `mapstructure:"vm_retry_max_attempts"`   // maximum attempts for retry (0 - infinity)
 	ReqTimeoutInMs uint `mapstructure:"vm_retry_req_timeout_ms"` // request timeout per attempt (0 - infinity) [ms]
+
+	// Retry / reconnect backoff
+	RetryInitialBackoffInMs uint    `mapstructure:"vm_retry_initial_backoff_ms"` // initial delay between attempts / reconnects [ms]
+	RetryMaxBackoffInMs     uint    `mapstructure:"vm_retry_max_backoff_ms"`     // max delay between attempts / reconnects [ms]
+	RetryBackoffMultiplier  float64 `mapstructure:"vm_retry_backoff_multiplier"` // delay multiplier after a failed attempt / reconnect
+
+	// Non-consensus requests (queries, simulations, CheckTx executions, events decoding): single attempt
+	QueryReqTimeoutInMs uint `mapstructure:"vm_query_req_timeout_ms"` // request timeout (0 - infinity) [ms]
+
+	// Connection health check
+	HealthCheckPeriodInMs  uint `mapstructure:"vm_health_check_period_ms"`  // health check period (0 - disabled) [ms]
+	HealthCheckTimeoutInMs uint `mapstructure:"vm_health_check_timeout_ms"` // health check request timeout [ms]
+
+	// DS server
+	DSCacheSize uint `mapstructure:"vm_ds_cache_size"` // DS server read cache size (0 - disabled) [entries]
+
+	// WriteSets indexer
+	WriteSetIndexEnabled bool   `mapstructure:"vm_writeset_index_enabled"` // per transaction VM writeSets indexer enabled
+	WriteSetIndexDir     string `mapstructure:"vm_writeset_index_dir"`     // indexer DB directory (absolute or relative to the home directory)
+
+	// Events
+	EventDecodeEnabled bool   `mapstructure:"vm_event_decode_enabled"` // decode events data to JSON using module metadata
+	EventIndexEnabled  bool   `mapstructure:"vm_event_index_enabled"`  // events indexer enabled
+	EventIndexDir      string `mapstructure:"vm_event_index_dir"`      // indexer DB directory (absolute or relative to the home directory)
 }
 
 // Default VM configuration.
 func DefaultVMConfig() *VMConfig {
 	return &VMConfig{
-		Address:        DefaultVMAddress,
-		DataListen:     DefaultDataListen,
-		MaxAttempts:    DefaultMaxAttempts,
-		ReqTimeoutInMs: DefaultReqTimeout,
+		Address:                 DefaultVMAddress,
+		DataListen:              DefaultDataListen,
+		MaxAttempts:             DefaultMaxAttempts,
+		ReqTimeoutInMs:          DefaultReqTimeout,
+		RetryInitialBackoffInMs: DefaultRetryInitialBackoff,
+		RetryMaxBackoffInMs:     DefaultRetryMaxBackoff,
+		RetryBackoffMultiplier:  DefaultRetryBackoffMultiplier,
+		QueryReqTimeoutInMs:     DefaultQueryReqTimeout,
+		HealthCheckPeriodInMs:   DefaultHealthCheckPeriod,
+		HealthCheckTimeoutInMs:  DefaultHealthCheckTimeout,
+		DSCacheSize:             DefaultDSCacheSize,
+		WriteSetIndexDir:        DefaultWriteSetIndexDir,
+		EventIndexDir:           DefaultEventIndexDir,
 	}
 }
 
@@ -66,6 +118,7 @@ func ReadVMConfig(rootDir string) (*VMConfig, error) {
 	if _, err := os.Stat(configFilePath); os.IsNotExist(err) {
 		config := DefaultVMConfig()
 		WriteVMConfig(rootDir, config)
+		resolveIndexDirs(rootDir, config)
 		return config, nil
 	}
 
@@ -81,5 +134,25 @@ func ReadVMConfig(rootDir string) (*VMConfig, error) {
 		panic(err)
 	}
 
+	resolveIndexDirs(rootDir, config)
+
 	return config, nil
 }
+
+// resolveIndexDirs converts relative indexer directories to absolute ones.
+func resolveIndexDirs(rootDir string, config *VMConfig) {
+	config.WriteSetIndexDir = resolveIndexDir(rootDir, config.WriteSetIndexDir, DefaultWriteSetIndexDir)
+	config.EventIndexDir = resolveIndexDir(rootDir, config.EventIndexDir, DefaultEventIndexDir)
+}
+
+// resolveIndexDir returns absolute indexer directory.
+func resolveIndexDir(rootDir, dir, defaultDir string) string {
+	if dir == "" {
+		dir = defaultDir
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(rootDir, dir)
+	}
+
+	return dir
+}
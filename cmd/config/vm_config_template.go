@@ -35,4 +35,52 @@ vm_retry_max_attempts = {{ .MaxAttempts }}
 ## Request timeout per attempt in ms.
 ## Default is 0 - infinite (no timeout).
 vm_retry_req_timeout_ms = {{ .ReqTimeoutInMs }}
+
+## Initial delay between failed attempts / reconnects in ms.
+vm_retry_initial_backoff_ms = {{ .RetryInitialBackoffInMs }}
+
+## Max delay between failed attempts / reconnects in ms.
+vm_retry_max_backoff_ms = {{ .RetryMaxBackoffInMs }}
+
+## Delay multiplier applied after every failed attempt / reconnect.
+vm_retry_backoff_multiplier = {{ .RetryBackoffMultiplier }}
+
+## Non-consensus request (queries, simulations, CheckTx executions, events decoding) timeout in ms.
+## Those requests are sent with a single attempt (no retries).
+## 0 - infinite (no timeout).
+vm_query_req_timeout_ms = {{ .QueryReqTimeoutInMs }}
+
+# VM connection health check settings (VM Txs are rejected on CheckTx while VM is unavailable).
+
+## Health check period in ms.
+## 0 - health check is disabled.
+vm_health_check_period_ms = {{ .HealthCheckPeriodInMs }}
+
+## Health check request timeout in ms.
+vm_health_check_timeout_ms = {{ .HealthCheckTimeoutInMs }}
+
+# VM data server settings.
+
+## Read cache size (number of storage entries).
+## 0 - cache is disabled.
+vm_ds_cache_size = {{ .DSCacheSize }}
+
+# VM writeSets indexer settings (stores per transaction writeSets summary, used by the "tx-writeset" query).
+
+## Indexer enabled.
+vm_writeset_index_enabled = {{ .WriteSetIndexEnabled }}
+
+## Indexer DB directory (absolute or relative to the home directory).
+vm_writeset_index_dir = "{{ .WriteSetIndexDir }}"
+
+# VM events settings.
+
+## Decode events data to JSON using module metadata (adds the "data_json" event attribute, node local option).
+vm_event_decode_enabled = {{ .EventDecodeEnabled }}
+
+## Events indexer enabled (stores events by type / sender, used by the "events" query).
+vm_event_index_enabled = {{ .EventIndexEnabled }}
+
+## Events indexer DB directory (absolute or relative to the home directory).
+vm_event_index_dir = "{{ .EventIndexDir }}"
 `
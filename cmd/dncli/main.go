@@ -105,6 +105,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 
 	queryCmd.AddCommand(
 		vmauthCli.GetAccountCmd(cdc),
+		vmauthCli.GetBalanceMismatchCmd(cdc),
 		flags.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
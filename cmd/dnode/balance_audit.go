@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/dfinance/dnode/app"
+	dnConfig "github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/cmd/config/restrictions"
+	"github.com/dfinance/dnode/x/vmauth"
+)
+
+const (
+	flagAuditHeight    = "height"
+	flagAuditFixOutput = "fix-output"
+	flagAuditFixSource = "fix-source"
+	//
+	auditFixSourceVM   = "vm"
+	auditFixSourceAuth = "auth"
+)
+
+// AuditVMBalancesCmd compares auth coins with ccstorage VM balance resources for all accounts (offline, app DB is used).
+// Optionally writes a genesis file with fixed balances.
+func AuditVMBalancesCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "audit-vm-balances",
+		Short:   "Report accounts with auth coins and VM balance resources mismatch (node must be stopped)",
+		Example: "audit-vm-balances --height 1000 --fix-output ./genesis_fixed.json --fix-source vm",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			// parse inputs
+			height := viper.GetInt64(flagAuditHeight)
+			fixOutput := viper.GetString(flagAuditFixOutput)
+			fixSource := viper.GetString(flagAuditFixSource)
+			if fixSource != auditFixSourceVM && fixSource != auditFixSourceAuth {
+				return fmt.Errorf("%s flag: unknown value %q (%s / %s expected)", flagAuditFixSource, fixSource, auditFixSourceVM, auditFixSourceAuth)
+			}
+
+			// load app
+			vmConfig, err := dnConfig.ReadVMConfig(config.RootDir)
+			if err != nil {
+				return fmt.Errorf("reading VM config: %w", err)
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("opening app DB: %w", err)
+			}
+			defer db.Close()
+
+			dnApp := app.NewDnServiceApp(ctx.Logger, db, vmConfig, dnConfig.DefInvCheckPeriod, restrictions.GetAppRestrictions())
+			if height != -1 {
+				if err := dnApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("loading height %d: %w", height, err)
+				}
+			}
+
+			// audit only
+			if fixOutput == "" {
+				mismatches, err := dnApp.AuditVMBalances()
+				if err != nil {
+					return err
+				}
+
+				return printAuditReport(cdc, dnApp.LastBlockHeight(), mismatches)
+			}
+
+			// audit and fix
+			appState, validators, mismatches, err := dnApp.ExportAppStateWithFixedVMBalances(fixSource == auditFixSourceVM)
+			if err != nil {
+				return err
+			}
+
+			doc, err := tmTypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return fmt.Errorf("reading genesis file: %w", err)
+			}
+			doc.AppState = appState
+			doc.Validators = validators
+
+			docBz, err := codec.MarshalJSONIndent(cdc, doc)
+			if err != nil {
+				return fmt.Errorf("genesis JSON marshal: %w", err)
+			}
+
+			if err := ioutil.WriteFile(fixOutput, docBz, 0644); err != nil {
+				return fmt.Errorf("writing fixed genesis: %w", err)
+			}
+
+			return printAuditReport(cdc, dnApp.LastBlockHeight(), mismatches)
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Int64(flagAuditHeight, -1, "audit state at a particular height (default is the latest)")
+	cmd.Flags().String(flagAuditFixOutput, "", "fixed genesis output file path (no fix if empty)")
+	cmd.Flags().String(flagAuditFixSource, auditFixSourceVM, "source of truth for the fix: vm (balance resources) / auth (auth coins)")
+
+	return cmd
+}
+
+// printAuditReport prints mismatches in JSON format.
+func printAuditReport(cdc *codec.Codec, height int64, mismatches vmauth.BalanceMismatches) error {
+	report := struct {
+		Height     int64                    `json:"height"`
+		Mismatches vmauth.BalanceMismatches `json:"mismatches"`
+	}{
+		Height:     height,
+		Mismatches: mismatches,
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, report)
+	if err != nil {
+		return fmt.Errorf("report JSON marshal: %w", err)
+	}
+	fmt.Println(string(bz))
+
+	return nil
+}
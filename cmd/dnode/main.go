@@ -77,6 +77,8 @@ func main() {
 		oracleCli.AddAssetGenCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 		marketsCli.AddMarketGenCmd(ctx, cdc, app.DefaultNodeHome),
 		migrationCli.MigrateGenesisCmd(ctx, cdc),
+		AuditVMBalancesCmd(ctx, cdc),
+		ExportVMWriteSetCmd(ctx, cdc),
 	)
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/dfinance/dnode/app"
+	dnConfig "github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/cmd/config/restrictions"
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm"
+)
+
+const (
+	flagWSExportHeight     = "height"
+	flagWSExportAddresses  = "addresses"
+	flagWSExportPrefixes   = "path-prefixes"
+	flagWSExportOutputFile = "to-file"
+)
+
+// ExportVMWriteSetCmd exports VM writeSets from the app DB (offline) in the read-genesis-write-set cmd JSON format.
+func ExportVMWriteSetCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export-vm-write-set",
+		Short:   "Export VM writeSets at a particular height to JSON file (node must be stopped)",
+		Example: "export-vm-write-set --height 1000 --addresses wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --to-file ./write_set.json",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			// parse inputs
+			height := viper.GetInt64(flagWSExportHeight)
+			outputPath := viper.GetString(flagWSExportOutputFile)
+
+			filter := vm.WriteSetFilter{}
+			for _, addressStr := range viper.GetStringSlice(flagWSExportAddresses) {
+				address, err := helpers.ParseSdkAddressParam(flagWSExportAddresses, addressStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				filter.Addresses = append(filter.Addresses, common_vm.Bech32ToLibra(address))
+			}
+			for _, prefixStr := range viper.GetStringSlice(flagWSExportPrefixes) {
+				_, prefix, err := helpers.ParseHexStringParam(flagWSExportPrefixes, prefixStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				filter.PathPrefixes = append(filter.PathPrefixes, prefix)
+			}
+
+			// load app
+			vmConfig, err := dnConfig.ReadVMConfig(config.RootDir)
+			if err != nil {
+				return fmt.Errorf("reading VM config: %w", err)
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("opening app DB: %w", err)
+			}
+			defer db.Close()
+
+			dnApp := app.NewDnServiceApp(ctx.Logger, db, vmConfig, dnConfig.DefInvCheckPeriod, restrictions.GetAppRestrictions())
+			if height != -1 {
+				if err := dnApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("loading height %d: %w", height, err)
+				}
+			}
+
+			// export
+			state := dnApp.ExportVMWriteSet(filter)
+
+			stateBz, err := codec.MarshalJSONIndent(cdc, state)
+			if err != nil {
+				return fmt.Errorf("writeSets JSON marshal: %w", err)
+			}
+
+			if outputPath == "" {
+				fmt.Println(string(stateBz))
+				return nil
+			}
+
+			if err := ioutil.WriteFile(outputPath, stateBz, 0644); err != nil {
+				return fmt.Errorf("writing writeSets file: %w", err)
+			}
+			fmt.Printf("Height %d: %d writeSets and %d modules exported to %s\n", dnApp.LastBlockHeight(), len(state.WriteSet), len(state.Modules), outputPath)
+
+			return nil
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Int64(flagWSExportHeight, -1, "export state at a particular height (default is the latest)")
+	cmd.Flags().StringSlice(flagWSExportAddresses, nil, "comma separated VM addresses filter (Bech32 / HEX string)")
+	cmd.Flags().StringSlice(flagWSExportPrefixes, nil, "comma separated VM path prefixes filter (HEX string)")
+	cmd.Flags().String(flagWSExportOutputFile, "", "output file path (stdout if empty)")
+
+	return cmd
+}
@@ -54,7 +54,8 @@ func GetGRpcNetListener(addr string) (net.Listener, error) {
 
 // Get gRPC client connection for UNIX/TCP address string.
 // Keep alive option is not added if {keepAlivePeriod} == 0.
-func GetGRpcClientConnection(addr string, keepAlivePeriod time.Duration) (*grpc.ClientConn, error) {
+// Optional {opts} are appended to the default dial options.
+func GetGRpcClientConnection(addr string, keepAlivePeriod time.Duration, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	schema, address, err := parseGRpcAddress(addr)
 	if err != nil {
 		return nil, err
@@ -81,6 +82,7 @@ func GetGRpcClientConnection(addr string, keepAlivePeriod time.Duration) (*grpc.
 
 		dialOptions = append(dialOptions, grpc.WithKeepaliveParams(kpParams))
 	}
+	dialOptions = append(dialOptions, opts...)
 
 	// Bypass Rust h2 library UDS limitations: uri validation failure causing PROTOCOL_ERROR gRPC error
 	dialAddress :=  address
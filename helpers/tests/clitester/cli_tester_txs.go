@@ -314,6 +314,41 @@ func (ct *CLITester) TxCCAddCurrencyProposal(fromAddress, denom, balancePath, in
 	return r
 }
 
+func (ct *CLITester) TxCCAddTokenCurrencyProposal(fromAddress, denom, moduleAddress, moduleName, structName string, deposit sdk.Coin) *TxRequest {
+	cmdArgs := []string{
+		"add-token-currency-proposal",
+		denom,
+		moduleAddress,
+		moduleName,
+		structName,
+		fmt.Sprintf("--deposit=%s", deposit.String()),
+	}
+
+	r := ct.newTxRequest()
+	r.SetCmd(
+		"currencies",
+		fromAddress,
+		cmdArgs...)
+
+	return r
+}
+
+func (ct *CLITester) TxCCFreezeCurrencyProposal(fromAddress, denom string, deposit sdk.Coin) *TxRequest {
+	cmdArgs := []string{
+		"freeze-currency-proposal",
+		denom,
+		fmt.Sprintf("--deposit=%s", deposit.String()),
+	}
+
+	r := ct.newTxRequest()
+	r.SetCmd(
+		"currencies",
+		fromAddress,
+		cmdArgs...)
+
+	return r
+}
+
 func (ct *CLITester) TxGovDeposit(fromAddress string, id uint64, amount uint64, denom string) *TxRequest {
 	cmdArgs := []string{
 		"deposit",
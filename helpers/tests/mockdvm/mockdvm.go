@@ -1,12 +1,21 @@
+// Package mockdvm provides a scriptable fake DVM backend for tests.
+// Backend can be served over gRPC (StartMockDVMService) or used in-process (NewMockDVM, MockDVM.Client).
+// Execution responses (writeSets, events) and module metadata are configured per script / module code hash,
+// not configured code execution results in the "abort" VM status.
 package mockdvm
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/dfinance/dvm-proto/go/compiler_grpc"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,12 +29,18 @@ type MockDVM struct {
 	failResponse  bool
 	failCountdown uint
 	execDelay     time.Duration
+	unavailable   int32
+	// configured responses (key: code hash)
+	execResults map[string]*vm_grpc.VMExecuteResponse
+	modulesMeta map[string]*metadata_grpc.ModuleMeta
 }
 
 func (s *MockDVM) SetExecutionFail() { s.failExecution = true }
 func (s *MockDVM) SetExecutionOK()   { s.failExecution = false }
 func (s *MockDVM) SetResponseFail()  { s.failResponse = true }
 func (s *MockDVM) SetResponseOK()    { s.failResponse = false }
+func (s *MockDVM) SetUnavailable()   { s.setUnavailable(true) }
+func (s *MockDVM) SetAvailable()     { s.setUnavailable(false) }
 func (s *MockDVM) SetExecutionDelay(dur time.Duration) {
 	s.execDelay = dur
 }
@@ -38,7 +53,68 @@ func (s *MockDVM) Stop() {
 	}
 }
 
+// SetExecResult configures a successful execution response with {writeSet} and {events} for the script / module {code}.
+func (s *MockDVM) SetExecResult(code []byte, writeSet []*vm_grpc.VMValue, events []*vm_grpc.VMEvent) {
+	s.SetExecResponse(code, &vm_grpc.VMExecuteResponse{
+		WriteSet: writeSet,
+		Events:   events,
+		GasUsed:  1,
+		Status:   &vm_grpc.VMStatus{},
+	})
+}
+
+// SetExecResponse configures the execution response for the script / module {code}.
+func (s *MockDVM) SetExecResponse(code []byte, resp *vm_grpc.VMExecuteResponse) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.execResults[codeHash(code)] = resp
+}
+
+// SetModuleMetadata configures the metadata response for the module {code}.
+func (s *MockDVM) SetModuleMetadata(code []byte, meta *metadata_grpc.ModuleMeta) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.modulesMeta[codeHash(code)] = meta
+}
+
+// Client returns the in-process MockDVM client (no network connection is used).
+func (s *MockDVM) Client() Client {
+	return Client{dvm: s}
+}
+
 func (s *MockDVM) PublishModule(ctx context.Context, in *vm_grpc.VMPublishModule) (*vm_grpc.VMExecuteResponse, error) {
+	return s.execute(in.Code)
+}
+
+func (s *MockDVM) ExecuteScript(ctx context.Context, in *vm_grpc.VMExecuteScript) (*vm_grpc.VMExecuteResponse, error) {
+	return s.execute(in.Code)
+}
+
+// GetMetadata returns configured module metadata, it is also used as a health check.
+func (s *MockDVM) GetMetadata(ctx context.Context, in *metadata_grpc.Bytecode) (*metadata_grpc.Metadata, error) {
+	if s.isUnavailable() {
+		return nil, grpcStatus.Errorf(codes.Unavailable, "DVM is unavailable")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	meta, ok := s.modulesMeta[codeHash(in.Code)]
+	if !ok {
+		return nil, grpcStatus.Errorf(codes.NotFound, "module metadata not configured")
+	}
+
+	return &metadata_grpc.Metadata{Meta: &metadata_grpc.Metadata_Module{Module: meta}}, nil
+}
+
+// execute returns configured / default execution response emulating execution delay and failures.
+func (s *MockDVM) execute(code []byte) (*vm_grpc.VMExecuteResponse, error) {
+	if s.isUnavailable() {
+		return nil, grpcStatus.Errorf(codes.Unavailable, "DVM is unavailable")
+	}
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -52,6 +128,10 @@ func (s *MockDVM) PublishModule(ctx context.Context, in *vm_grpc.VMPublishModule
 		return nil, grpcStatus.Errorf(codes.Internal, "failing gRPC execution")
 	}
 
+	if resp, ok := s.execResults[codeHash(code)]; ok {
+		return resp, nil
+	}
+
 	resp := &vm_grpc.VMExecuteResponse{}
 	if !s.failResponse {
 		resp = &vm_grpc.VMExecuteResponse{
@@ -59,7 +139,7 @@ func (s *MockDVM) PublishModule(ctx context.Context, in *vm_grpc.VMPublishModule
 			Events:   nil,
 			GasUsed:  1,
 			Status: &vm_grpc.VMStatus{
-				Error: &vm_grpc.VMStatus_Abort{},
+				Error: &vm_grpc.VMStatus_Abort{Abort: &vm_grpc.Abort{}},
 			},
 		}
 	}
@@ -67,13 +147,55 @@ func (s *MockDVM) PublishModule(ctx context.Context, in *vm_grpc.VMPublishModule
 	return resp, nil
 }
 
-func StartMockDVMService(listener net.Listener) *MockDVM {
-	s := &MockDVM{
-		execDelay: 100 * time.Millisecond,
+func (s *MockDVM) setUnavailable(value bool) {
+	v := int32(0)
+	if value {
+		v = 1
+	}
+	atomic.StoreInt32(&s.unavailable, v)
+}
+
+func (s *MockDVM) isUnavailable() bool {
+	return atomic.LoadInt32(&s.unavailable) == 1
+}
+
+// Client is an in-process MockDVM client implementing DVM gRPC services client interfaces.
+type Client struct {
+	dvm *MockDVM
+}
+
+func (c Client) Compile(ctx context.Context, in *compiler_grpc.SourceFiles, opts ...grpc.CallOption) (*compiler_grpc.CompilationResult, error) {
+	return nil, grpcStatus.Errorf(codes.Unimplemented, "compilation is not supported")
+}
+
+func (c Client) GetMetadata(ctx context.Context, in *metadata_grpc.Bytecode, opts ...grpc.CallOption) (*metadata_grpc.Metadata, error) {
+	return c.dvm.GetMetadata(ctx, in)
+}
+
+func (c Client) PublishModule(ctx context.Context, in *vm_grpc.VMPublishModule, opts ...grpc.CallOption) (*vm_grpc.VMExecuteResponse, error) {
+	return c.dvm.PublishModule(ctx, in)
+}
+
+func (c Client) ExecuteScript(ctx context.Context, in *vm_grpc.VMExecuteScript, opts ...grpc.CallOption) (*vm_grpc.VMExecuteResponse, error) {
+	return c.dvm.ExecuteScript(ctx, in)
+}
+
+// NewMockDVM creates a new in-process MockDVM backend.
+func NewMockDVM() *MockDVM {
+	return &MockDVM{
+		execResults: make(map[string]*vm_grpc.VMExecuteResponse),
+		modulesMeta: make(map[string]*metadata_grpc.ModuleMeta),
 	}
+}
+
+func StartMockDVMService(listener net.Listener) *MockDVM {
+	s := NewMockDVM()
+	s.execDelay = 100 * time.Millisecond
 
 	server := grpc.NewServer()
 	vm_grpc.RegisterVMModulePublisherServer(server, s)
+	vm_grpc.RegisterVMScriptExecutorServer(server, s)
+	metadata_grpc.RegisterDVMBytecodeMetadataServer(server, s)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -84,3 +206,10 @@ func StartMockDVMService(listener net.Listener) *MockDVM {
 
 	return s
 }
+
+// codeHash returns configured responses key.
+func codeHash(code []byte) string {
+	hash := sha256.Sum256(code)
+
+	return hex.EncodeToString(hash[:])
+}
@@ -11,11 +11,16 @@ type (
 	Currency        = types.Currency
 	Currencies      = types.Currencies
 	CurrencyParams  = types.CurrencyParams
+	CurrencyVMToken = types.CurrencyVMToken
 	ResCurrencyInfo = types.ResCurrencyInfo
 	ResBalance      = types.ResBalance
 	Balance         = types.Balance
 	Balances        = types.Balances
 	//
+	ResCurrencyFrozenInfo = types.ResCurrencyFrozenInfo
+	TokenCurrencyParams   = types.TokenCurrencyParams
+	TokenCurrenciesParams = types.TokenCurrenciesParams
+	//
 	SquashOptions = keeper.SquashOptions
 )
 
@@ -24,6 +29,7 @@ const (
 	StoreKey   = types.StoreKey
 	// Event types, attribute types and values
 	EventTypesCreate = types.EventTypesCreate
+	EventTypesFreeze = types.EventTypesFreeze
 	//
 	AttributeDenom    = types.AttributeDenom
 	AttributeDecimals = types.AttributeDecimals
@@ -38,11 +44,17 @@ var (
 	NewKeeper           = keeper.NewKeeper
 	DefaultGenesisState = types.DefaultGenesisState
 	//
+	NewResTokenCurrencyInfo = types.NewResTokenCurrencyInfo
+	//
 	NewEmptySquashOptions = keeper.NewEmptySquashOptions
+	//
+	NewCurrencyInfoDSMiddleware = keeper.NewCurrencyInfoDSMiddleware
+	NewFrozenInfoDSMiddleware   = keeper.NewFrozenInfoDSMiddleware
 	// perms requests
 	RequestVMStoragePerms = types.RequestVMStoragePerms
 	// errors
 	ErrInternal    = types.ErrInternal
 	ErrWrongDenom  = types.ErrWrongDenom
 	ErrWrongParams = types.ErrWrongParams
+	ErrFrozenDenom = types.ErrFrozenDenom
 )
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/dfinance/dnode/x/ccstorage/internal/types"
+	"github.com/dfinance/dnode/x/vm/client/vm_client"
+)
+
+const (
+	// Currencies list query route (currencies module querier)
+	currenciesQueryRoute = "custom/currencies/currencies"
+)
+
+// decodeWriteSetValue decodes VM balance and currency info resources.
+func decodeWriteSetValue(cliCtx context.CLIContext, address, path, value []byte) (string, bool) {
+	res, _, err := cliCtx.Query(currenciesQueryRoute)
+	if err != nil {
+		return "", false
+	}
+
+	var currencies types.Currencies
+	if err := cliCtx.Codec.UnmarshalJSON(res, &currencies); err != nil {
+		return "", false
+	}
+
+	for _, currency := range currencies {
+		if bytes.Equal(path, currency.BalancePath()) {
+			resBalance, err := types.NewResBalance(value)
+			if err != nil {
+				return "", false
+			}
+
+			return fmt.Sprintf("Balance: %s%s", resBalance.Value.String(), currency.Denom), true
+		}
+
+		if bytes.Equal(path, currency.InfoPath()) && bytes.Equal(address, currency.InfoAddress()) {
+			resInfo, err := types.NewResTokenCurrencyInfo(value)
+			if err != nil {
+				return "", false
+			}
+
+			return resInfo.String(), true
+		}
+	}
+
+	return "", false
+}
+
+func init() {
+	vm_client.RegisterWriteSetValueDecoder(decodeWriteSetValue)
+}
@@ -24,6 +24,7 @@ type TestInput struct {
 	ctx sdk.Context
 	//
 	keyCCStorage *sdk.KVStoreKey
+	keyParams    *sdk.KVStoreKey
 	tkeyParams   *sdk.TransientStoreKey
 	keyVMS       *sdk.KVStoreKey
 	//
@@ -37,6 +38,7 @@ func NewTestInput(t *testing.T) TestInput {
 		cdc:          codec.New(),
 		keyCCStorage: sdk.NewKVStoreKey(types.StoreKey),
 		keyVMS:       sdk.NewKVStoreKey(vm.StoreKey),
+		keyParams:    sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:   sdk.NewTransientStoreKey(params.TStoreKey),
 	}
 
@@ -49,6 +51,7 @@ func NewTestInput(t *testing.T) TestInput {
 	mstore := store.NewCommitMultiStore(db)
 	mstore.MountStoreWithDB(input.keyCCStorage, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyVMS, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyParams, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.tkeyParams, sdk.StoreTypeTransient, db)
 	err := mstore.LoadLatestVersion()
 	if err != nil {
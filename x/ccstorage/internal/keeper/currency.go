@@ -26,6 +26,8 @@ func (k Keeper) CreateCurrency(ctx sdk.Context, params types.CurrencyParams) err
 
 	// store currency objects
 	k.storeCurrency(ctx, currency)
+	k.storeCurrencyInfoPathDenom(ctx, currency)
+	k.storeFrozenInfoPathDenom(ctx, currency)
 	k.storeResStdCurrencyInfo(ctx, currency)
 
 	ctx.EventManager().EmitEvent(types.NewCCCreatedEvent(currency))
@@ -33,6 +35,76 @@ func (k Keeper) CreateCurrency(ctx sdk.Context, params types.CurrencyParams) err
 	return nil
 }
 
+// CreateTokenCurrency creates a new currency object for VM-native token.
+// Token CurrencyInfo resource must exist (created by 0x1::Dfinance::tokenize), decimals and supply are taken from it.
+func (k Keeper) CreateTokenCurrency(ctx sdk.Context, params types.TokenCurrencyParams) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	denom := params.Denom
+	if err := params.Validate(); err != nil {
+		return sdkErrors.Wrapf(types.ErrWrongParams, "token currency %q: %v", denom, err)
+	}
+	if k.HasCurrency(ctx, denom) {
+		return sdkErrors.Wrapf(types.ErrWrongDenom, "currency %q: exists", denom)
+	}
+	for _, currency := range k.GetCurrencies(ctx) {
+		if currency.IsToken() && currency.VMToken.String() == params.Token.String() {
+			return sdkErrors.Wrapf(types.ErrWrongParams, "token %s: registered as %q", params.Token, currency.Denom)
+		}
+	}
+
+	currencyInfo, err := k.getResTokenCurrencyInfo(ctx, params.Token)
+	if err != nil {
+		return sdkErrors.Wrapf(types.ErrWrongParams, "token currency %q: %v", denom, err)
+	}
+
+	// build currency object
+	token := params.Token
+	currency := types.NewCurrency(types.CurrencyParams{Denom: denom, Decimals: currencyInfo.Decimals}, sdk.NewIntFromBigInt(currencyInfo.TotalSupply))
+	currency.VMToken = &token
+	if err := currency.Valid(); err != nil {
+		return sdkErrors.Wrapf(types.ErrWrongParams, "token currency %q: %v", denom, err)
+	}
+
+	// store currency object (CurrencyInfo resource is owned by VM)
+	k.storeCurrency(ctx, currency)
+	k.storeFrozenInfoPathDenom(ctx, currency)
+
+	ctx.EventManager().EmitEvent(types.NewCCCreatedEvent(currency))
+
+	return nil
+}
+
+// UpdateTokenCurrencySupply sets VM-native token currency supply from VM CurrencyInfo resource.
+// Returns old and new supply.
+func (k Keeper) UpdateTokenCurrencySupply(ctx sdk.Context, denom string) (oldSupply, newSupply sdk.Int, retErr error) {
+	k.modulePerms.AutoCheck(types.PermUpdate)
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		retErr = err
+		return
+	}
+	if !currency.IsToken() {
+		retErr = sdkErrors.Wrapf(types.ErrWrongDenom, "currency %q: not a VM token", denom)
+		return
+	}
+
+	currencyInfo, err := k.getResTokenCurrencyInfo(ctx, *currency.VMToken)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	oldSupply, newSupply = currency.Supply, sdk.NewIntFromBigInt(currencyInfo.TotalSupply)
+	if !oldSupply.Equal(newSupply) {
+		currency.Supply = newSupply
+		k.storeCurrency(ctx, currency)
+	}
+
+	return
+}
+
 // HasCurrency checks that currency exists.
 func (k Keeper) HasCurrency(ctx sdk.Context, denom string) bool {
 	k.modulePerms.AutoCheck(types.PermRead)
@@ -72,7 +144,7 @@ func (k Keeper) GetCurrency(ctx sdk.Context, denom string) (types.Currency, erro
 	return k.getCurrency(ctx, denom), nil
 }
 
-// IncreaseCurrencySupply increases currency supply and updates VM resources.
+// IncreaseCurrencySupply increases currency supply and updates VM resources (skipped for VM-native tokens).
 func (k Keeper) IncreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	k.modulePerms.AutoCheck(types.PermUpdate)
 
@@ -80,6 +152,9 @@ func (k Keeper) IncreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	if err != nil {
 		return err
 	}
+	if currency.IsToken() {
+		return nil
+	}
 	currency.Supply = currency.Supply.Add(coin.Amount)
 
 	k.storeCurrency(ctx, currency)
@@ -88,7 +163,7 @@ func (k Keeper) IncreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	return nil
 }
 
-// DecreaseCurrencySupply reduces currency supply and updates VM resources.
+// DecreaseCurrencySupply reduces currency supply and updates VM resources (skipped for VM-native tokens).
 func (k Keeper) DecreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	k.modulePerms.AutoCheck(types.PermUpdate)
 
@@ -96,6 +171,9 @@ func (k Keeper) DecreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	if err != nil {
 		return err
 	}
+	if currency.IsToken() {
+		return nil
+	}
 	currency.Supply = currency.Supply.Sub(coin.Amount)
 
 	k.storeCurrency(ctx, currency)
@@ -104,6 +182,39 @@ func (k Keeper) DecreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	return nil
 }
 
+// FreezeCurrency marks currency as frozen.
+// VM CurrencyInfo resource is not changed, frozen state is served to VM by the FrozenInfo DS middleware.
+func (k Keeper) FreezeCurrency(ctx sdk.Context, denom string) error {
+	k.modulePerms.AutoCheck(types.PermUpdate)
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if currency.Frozen {
+		return sdkErrors.Wrapf(types.ErrFrozenDenom, "currency %q: already frozen", denom)
+	}
+	currency.Frozen = true
+
+	k.storeCurrency(ctx, currency)
+	k.storeFrozenInfoPathDenom(ctx, currency)
+
+	ctx.EventManager().EmitEvent(types.NewCCFrozenEvent(currency))
+
+	return nil
+}
+
+// IsCurrencyFrozen checks that currency exists and is frozen.
+func (k Keeper) IsCurrencyFrozen(ctx sdk.Context, denom string) bool {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.HasCurrency(ctx, denom) {
+		return false
+	}
+
+	return k.getCurrency(ctx, denom).Frozen
+}
+
 // getCurrency returns currency from the storage
 func (k Keeper) getCurrency(ctx sdk.Context, denom string) types.Currency {
 	store := ctx.KVStore(k.storeKey)
@@ -120,3 +231,38 @@ func (k Keeper) storeCurrency(ctx sdk.Context, currency types.Currency) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetCurrencyKey(currency.Denom), k.cdc.MustMarshalBinaryBare(currency))
 }
+
+// getCurrencyInfoPathDenom returns standard currency denom by its CurrencyInfo VM path.
+func (k Keeper) getCurrencyInfoPathDenom(ctx sdk.Context, path []byte) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCurrencyInfoPathDenomKey(path))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// storeCurrencyInfoPathDenom sets standard currency CurrencyInfo VM path to denom index (used by the DS middleware).
+func (k Keeper) storeCurrencyInfoPathDenom(ctx sdk.Context, currency types.Currency) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCurrencyInfoPathDenomKey(currency.InfoPath()), []byte(currency.Denom))
+}
+
+// getFrozenInfoPathDenom returns currency denom by its FrozenInfo VM path.
+func (k Keeper) getFrozenInfoPathDenom(ctx sdk.Context, path []byte) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFrozenInfoPathDenomKey(path))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// storeFrozenInfoPathDenom sets currency FrozenInfo VM path to denom index (used by the DS middleware).
+// Index is also set on freeze, so frozen currencies created before the index was introduced are served as well.
+func (k Keeper) storeFrozenInfoPathDenom(ctx sdk.Context, currency types.Currency) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFrozenInfoPathDenomKey(currency.FrozenInfoPath()), []byte(currency.Denom))
+}
@@ -3,10 +3,13 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/ccstorage/internal/types"
@@ -163,6 +166,52 @@ func TestCCSKeeper_Supply(t *testing.T) {
 	}
 }
 
+// Test keeper FreezeCurrency / IsCurrencyFrozen methods.
+func TestCCSKeeper_FreezeCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	// create currency
+	params := types.CurrencyParams{
+		Denom:    "test",
+		Decimals: uint8(8),
+	}
+	denom := params.Denom
+
+	err := keeper.CreateCurrency(ctx, params)
+	require.NoError(t, err)
+	require.False(t, keeper.IsCurrencyFrozen(ctx, denom))
+
+	// ok
+	{
+		require.NoError(t, keeper.FreezeCurrency(ctx, denom))
+		require.True(t, keeper.IsCurrencyFrozen(ctx, denom))
+
+		currency, err := keeper.GetCurrency(ctx, denom)
+		require.NoError(t, err)
+		require.True(t, currency.Frozen)
+	}
+
+	// ok: supply update keeps the flag
+	{
+		require.NoError(t, keeper.IncreaseCurrencySupply(ctx, sdk.NewCoin(denom, sdk.OneInt())))
+		require.True(t, keeper.IsCurrencyFrozen(ctx, denom))
+	}
+
+	// fail: already frozen
+	{
+		require.Error(t, keeper.FreezeCurrency(ctx, denom))
+	}
+
+	// fail: non-existing currency
+	{
+		require.Error(t, keeper.FreezeCurrency(ctx, "invalid"))
+		require.False(t, keeper.IsCurrencyFrozen(ctx, "invalid"))
+	}
+}
+
 func TestCCSKeeper_GetCurrencies(t *testing.T) {
 	t.Parallel()
 
@@ -174,3 +223,115 @@ func TestCCSKeeper_GetCurrencies(t *testing.T) {
 	currencies := keeper.GetCurrencies(ctx)
 	require.Len(t, currencies, len(defGenesis.CurrenciesParams))
 }
+
+// Test keeper CreateTokenCurrency and UpdateTokenCurrencySupply methods.
+func TestCCSKeeper_CreateTokenCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	params := types.TokenCurrencyParams{
+		Denom: "mytoken",
+		Token: types.CurrencyVMToken{
+			Address:    sdk.AccAddress("token_owner_address1"),
+			ModuleName: "MyToken",
+			StructName: "T",
+		},
+	}
+	infoPath := &vm_grpc.VMAccessPath{
+		Address: params.Token.VMAddress(),
+		Path:    params.Token.InfoPath(),
+	}
+
+	// setTokenInfo emulates 0x1::Dfinance::tokenize CurrencyInfo resource
+	setTokenInfo := func(supply int64) {
+		bz, err := lcs.Marshal(struct {
+			Denom       []byte
+			Decimals    uint8
+			IsToken     bool
+			Owner       []byte `lcs:"len=20"`
+			TotalSupply *big.Int
+		}{
+			Denom:       []byte(params.Denom),
+			Decimals:    6,
+			IsToken:     true,
+			Owner:       params.Token.VMAddress(),
+			TotalSupply: big.NewInt(supply),
+		})
+		require.NoError(t, err)
+		input.vmStorage.SetValue(ctx, infoPath, bz)
+	}
+
+	// fail: VM resource not found
+	{
+		err := keeper.CreateTokenCurrency(ctx, params)
+		require.Error(t, err)
+	}
+
+	// fail: invalid token
+	{
+		invalidParams := params
+		invalidParams.Token.StructName = ""
+		require.Error(t, keeper.CreateTokenCurrency(ctx, invalidParams))
+	}
+
+	// ok
+	{
+		setTokenInfo(1000)
+		require.NoError(t, keeper.CreateTokenCurrency(ctx, params))
+
+		currency, err := keeper.GetCurrency(ctx, params.Denom)
+		require.NoError(t, err)
+		require.True(t, currency.IsToken())
+		require.EqualValues(t, 6, currency.Decimals)
+		require.EqualValues(t, 1000, currency.Supply.Int64())
+		require.EqualValues(t, params.Token.BalancePath(), currency.BalancePath())
+		require.EqualValues(t, params.Token.InfoPath(), currency.InfoPath())
+		require.NotEqual(t, glav.BalanceVector(params.Denom), currency.BalancePath())
+
+		curInfo, err := keeper.GetResStdCurrencyInfo(ctx, params.Denom)
+		require.NoError(t, err)
+		require.True(t, curInfo.IsToken)
+		require.EqualValues(t, 1000, curInfo.TotalSupply.Int64())
+
+		// supply is managed by VM
+		require.NoError(t, keeper.IncreaseCurrencySupply(ctx, sdk.NewCoin(params.Denom, sdk.NewInt(1))))
+		currency, _ = keeper.GetCurrency(ctx, params.Denom)
+		require.EqualValues(t, 1000, currency.Supply.Int64())
+	}
+
+	// fail: existing denom and token
+	{
+		require.Error(t, keeper.CreateTokenCurrency(ctx, params))
+
+		otherParams := params
+		otherParams.Denom = "othertoken"
+		require.Error(t, keeper.CreateTokenCurrency(ctx, otherParams))
+	}
+
+	// ok: supply update
+	{
+		setTokenInfo(500)
+		oldSupply, newSupply, err := keeper.UpdateTokenCurrencySupply(ctx, params.Denom)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, oldSupply.Int64())
+		require.EqualValues(t, 500, newSupply.Int64())
+
+		currency, _ := keeper.GetCurrency(ctx, params.Denom)
+		require.EqualValues(t, 500, currency.Supply.Int64())
+	}
+
+	// fail: supply update for non-token
+	{
+		_, _, err := keeper.UpdateTokenCurrencySupply(ctx, "xfi")
+		require.Error(t, err)
+	}
+
+	// ok: token balance resource uses token path
+	{
+		addr := sdk.AccAddress("addr1")
+		balance := keeper.newBalance(ctx, addr, sdk.NewCoin(params.Denom, sdk.NewInt(10)))
+		require.EqualValues(t, params.Token.BalancePath(), balance.AccessPath.Path)
+	}
+}
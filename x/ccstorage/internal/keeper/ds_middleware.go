@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/lcs"
+
+	"github.com/dfinance/dnode/x/ccstorage/internal/types"
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// NewCurrencyInfoDSMiddleware creates VM DS server middleware which returns standard currency CurrencyInfo resource
+// computed on the fly from the currency object (VM-native tokens resources are served from the VM storage).
+// Path is matched using the CurrencyInfo path to denom index, so non-currency paths are skipped with a single store read.
+// Keeper pointer is used as the middleware is registered on the VM keeper creation (before the ccstorage keeper is created).
+func NewCurrencyInfoDSMiddleware(k *Keeper) common_vm.DSDataMiddleware {
+	return func(ctx sdk.Context, path *vm_grpc.VMAccessPath) ([]byte, error) {
+		if !bytes.Equal(path.Address, common_vm.StdLibAddress) {
+			return nil, nil
+		}
+
+		denom, ok := k.getCurrencyInfoPathDenom(ctx, path.Path)
+		if !ok || !k.HasCurrency(ctx, denom) {
+			return nil, nil
+		}
+
+		currency := k.getCurrency(ctx, denom)
+		if currency.IsToken() {
+			return nil, nil
+		}
+
+		currencyInfo, err := types.NewResCurrencyInfo(currency, common_vm.StdLibAddress)
+		if err != nil {
+			return nil, fmt.Errorf("currency %q: %w", currency.Denom, err)
+		}
+
+		bz, err := lcs.Marshal(currencyInfo)
+		if err != nil {
+			return nil, fmt.Errorf("currency %q: lcs marshal: %w", currency.Denom, err)
+		}
+
+		return bz, nil
+	}
+}
+
+// NewFrozenInfoDSMiddleware creates VM DS server middleware which returns currency FrozenInfo resource
+// (0x1::Dfinance::FrozenInfo<Coin>) computed on the fly from the currency object.
+// Path is matched using the FrozenInfo path to denom index, so non-currency paths are skipped with a single store read.
+// Keeper pointer is used as the middleware is registered on the VM keeper creation (before the ccstorage keeper is created).
+func NewFrozenInfoDSMiddleware(k *Keeper) common_vm.DSDataMiddleware {
+	return func(ctx sdk.Context, path *vm_grpc.VMAccessPath) ([]byte, error) {
+		if !bytes.Equal(path.Address, common_vm.StdLibAddress) {
+			return nil, nil
+		}
+
+		denom, ok := k.getFrozenInfoPathDenom(ctx, path.Path)
+		if !ok || !k.HasCurrency(ctx, denom) {
+			return nil, nil
+		}
+
+		currency := k.getCurrency(ctx, denom)
+		bz, err := lcs.Marshal(types.NewResCurrencyFrozenInfo(currency))
+		if err != nil {
+			return nil, fmt.Errorf("currency %q: lcs marshal: %w", currency.Denom, err)
+		}
+
+		return bz, nil
+	}
+}
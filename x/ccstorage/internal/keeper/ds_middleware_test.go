@@ -0,0 +1,118 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/ccstorage/internal/types"
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Test CurrencyInfo DS middleware paths matching using the CurrencyInfo path to denom index.
+func TestCCSKeeper_CurrencyInfoDSMiddleware(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	middleware := NewCurrencyInfoDSMiddleware(&keeper)
+
+	getInfoPath := func(denom string) *vm_grpc.VMAccessPath {
+		return &vm_grpc.VMAccessPath{Address: common_vm.StdLibAddress, Path: glav.CurrencyInfoVector(denom)}
+	}
+
+	// ok: non-stdlib and unknown paths are skipped
+	{
+		bz, err := middleware(ctx, &vm_grpc.VMAccessPath{Address: make([]byte, common_vm.VMAddressLength), Path: glav.CurrencyInfoVector("xfi")})
+		require.NoError(t, err)
+		require.Nil(t, bz)
+
+		bz, err = middleware(ctx, getInfoPath("test"))
+		require.NoError(t, err)
+		require.Nil(t, bz)
+	}
+
+	// ok: created currency
+	{
+		require.NoError(t, keeper.CreateCurrency(ctx, types.CurrencyParams{Denom: "test", Decimals: 8}))
+
+		bz, err := middleware(ctx, getInfoPath("test"))
+		require.NoError(t, err)
+
+		currency, err := keeper.GetCurrency(ctx, "test")
+		require.NoError(t, err)
+		currencyInfo, err := types.NewResCurrencyInfo(currency, common_vm.StdLibAddress)
+		require.NoError(t, err)
+		expectedBz, err := lcs.Marshal(currencyInfo)
+		require.NoError(t, err)
+		require.Equal(t, expectedBz, bz)
+	}
+
+	// ok: genesis currencies
+	{
+		bz, err := middleware(ctx, getInfoPath("xfi"))
+		require.NoError(t, err)
+		require.NotNil(t, bz)
+	}
+}
+
+// Test FrozenInfo DS middleware paths matching and currency frozen state.
+func TestCCSKeeper_FrozenInfoDSMiddleware(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	middleware := NewFrozenInfoDSMiddleware(&keeper)
+
+	checkFrozen := func(denom string, frozen bool) {
+		currency, err := keeper.GetCurrency(ctx, denom)
+		require.NoError(t, err)
+
+		bz, err := middleware(ctx, &vm_grpc.VMAccessPath{Address: common_vm.StdLibAddress, Path: currency.FrozenInfoPath()})
+		require.NoError(t, err)
+		require.NotNil(t, bz)
+
+		frozenInfo := types.ResCurrencyFrozenInfo{}
+		require.NoError(t, lcs.Unmarshal(bz, &frozenInfo))
+		require.Equal(t, frozen, frozenInfo.Frozen)
+	}
+
+	// ok: non-stdlib and unknown paths are skipped
+	{
+		currency := types.NewCurrency(types.CurrencyParams{Denom: "test", Decimals: 8}, sdk.ZeroInt())
+
+		bz, err := middleware(ctx, &vm_grpc.VMAccessPath{Address: make([]byte, common_vm.VMAddressLength), Path: currency.FrozenInfoPath()})
+		require.NoError(t, err)
+		require.Nil(t, bz)
+
+		bz, err = middleware(ctx, &vm_grpc.VMAccessPath{Address: common_vm.StdLibAddress, Path: currency.FrozenInfoPath()})
+		require.NoError(t, err)
+		require.Nil(t, bz)
+
+		bz, err = middleware(ctx, &vm_grpc.VMAccessPath{Address: common_vm.StdLibAddress, Path: currency.InfoPath()})
+		require.NoError(t, err)
+		require.Nil(t, bz)
+	}
+
+	// ok: genesis currency
+	{
+		checkFrozen("xfi", false)
+	}
+
+	// ok: created and frozen currency
+	{
+		require.NoError(t, keeper.CreateCurrency(ctx, types.CurrencyParams{Denom: "test", Decimals: 8}))
+		checkFrozen("test", false)
+
+		require.NoError(t, keeper.FreezeCurrency(ctx, "test"))
+		checkFrozen("test", true)
+	}
+}
@@ -20,6 +20,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 			panic(err)
 		}
 	}
+
+	for _, params := range state.VMTokens {
+		if err := k.CreateTokenCurrency(ctx, params); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, denom := range state.FrozenDenoms {
+		if err := k.FreezeCurrency(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
@@ -28,13 +40,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 	state := types.GenesisState{
 		CurrenciesParams: types.CurrenciesParams{},
+		FrozenDenoms:     []string{},
+		VMTokens:         types.TokenCurrenciesParams{},
 	}
 
 	for _, currency := range k.GetCurrencies(ctx) {
-		state.CurrenciesParams = append(state.CurrenciesParams, types.CurrencyParams{
-			Denom:    currency.Denom,
-			Decimals: currency.Decimals,
-		})
+		if currency.IsToken() {
+			state.VMTokens = append(state.VMTokens, types.TokenCurrencyParams{
+				Denom: currency.Denom,
+				Token: *currency.VMToken,
+			})
+		} else {
+			state.CurrenciesParams = append(state.CurrenciesParams, types.CurrencyParams{
+				Denom:    currency.Denom,
+				Decimals: currency.Decimals,
+			})
+		}
+
+		if currency.Frozen {
+			state.FrozenDenoms = append(state.FrozenDenoms, currency.Denom)
+		}
 	}
 
 	return k.cdc.MustMarshalJSON(state)
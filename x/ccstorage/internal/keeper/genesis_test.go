@@ -44,6 +44,8 @@ func TestCCSKeeper_ExportGenesis(t *testing.T) {
 	input := NewTestInput(t)
 	ctx, keeper := input.ctx, input.keeper
 
+	require.NoError(t, keeper.FreezeCurrency(ctx, "btc"))
+
 	state := types.GenesisState{}
 	bz := keeper.ExportGenesis(ctx)
 	input.cdc.MustUnmarshalJSON(bz, &state)
@@ -62,4 +64,5 @@ func TestCCSKeeper_ExportGenesis(t *testing.T) {
 		state.CurrenciesParams = append(state.CurrenciesParams[:foundIdx], state.CurrenciesParams[foundIdx+1:]...)
 	}
 	require.Empty(t, state.CurrenciesParams)
+	require.ElementsMatch(t, []string{"btc"}, state.FrozenDenoms)
 }
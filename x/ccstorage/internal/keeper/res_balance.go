@@ -84,12 +84,18 @@ func (k Keeper) RemoveAccountBalanceResources(ctx sdk.Context, addr sdk.AccAddre
 }
 
 // newBalance converts sdk.Coin for sdk.AccAddress to Balance.
-func (k Keeper) newBalance(addr sdk.AccAddress, coin sdk.Coin) types.Balance {
+// Currency BalancePath is used for registered currencies (VM-native tokens have a non-denom based path).
+func (k Keeper) newBalance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) types.Balance {
+	path := glav.BalanceVector(coin.Denom)
+	if k.HasCurrency(ctx, coin.Denom) {
+		path = k.getCurrency(ctx, coin.Denom).BalancePath()
+	}
+
 	return types.Balance{
 		Denom: coin.Denom,
 		AccessPath: &vm_grpc.VMAccessPath{
 			Address: common_vm.Bech32ToLibra(addr),
-			Path:    glav.BalanceVector(coin.Denom),
+			Path:    path,
 		},
 		Resource: types.ResBalance{
 			Value: coin.Amount.BigInt(),
@@ -106,7 +112,7 @@ func (k Keeper) newBalances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coin
 	filledBalances = make(types.Balances, 0, len(coins))
 	foundAccDenoms := make(map[string]bool, len(coins))
 	for _, coin := range coins {
-		balance := k.newBalance(addr, coin)
+		balance := k.newBalance(ctx, addr, coin)
 
 		filledBalances = append(filledBalances, balance)
 		foundAccDenoms[coin.Denom] = true
@@ -116,7 +122,7 @@ func (k Keeper) newBalances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coin
 	emptyBalances = make(types.Balances, 0)
 	for _, currency := range k.GetCurrencies(ctx) {
 		if !foundAccDenoms[currency.Denom] {
-			balance := k.newBalance(addr, sdk.NewCoin(currency.Denom, sdk.ZeroInt()))
+			balance := k.newBalance(ctx, addr, sdk.NewCoin(currency.Denom, sdk.ZeroInt()))
 
 			emptyBalances = append(emptyBalances, balance)
 		}
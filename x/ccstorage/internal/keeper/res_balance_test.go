@@ -8,13 +8,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/dfinance/glav"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/x/ccstorage/internal/types"
 	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm"
+	vmClient "github.com/dfinance/dnode/x/vm/client"
 )
 
 type balanceInput struct {
@@ -129,7 +135,7 @@ func TestCCSKeeper_newBalance(t *testing.T) {
 	t.Parallel()
 
 	input := NewTestInput(t)
-	keeper := input.keeper
+	ctx, keeper := input.ctx, input.keeper
 
 	addr := sdk.AccAddress("addr1")
 	coin := sdk.Coin{Amount: sdk.NewIntFromUint64(100)}
@@ -142,7 +148,7 @@ func TestCCSKeeper_newBalance(t *testing.T) {
 
 	// ok
 	{
-		balance := keeper.newBalance(addr, coin)
+		balance := keeper.newBalance(ctx, addr, coin)
 		path := glav.BalanceVector(coin.Denom)
 
 		require.Equal(t, coin.Denom, balance.Denom)
@@ -276,3 +282,53 @@ func TestCCSKeeper_AccountBalanceResources(t *testing.T) {
 		require.Len(t, balances, 0)
 	}
 }
+
+// Test checks account balance resources updated by VM script execution (in-process mockDVM backend).
+func TestCCSKeeper_VMBalanceResources(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	mockDVM := mockdvm.NewMockDVM()
+
+	// replace test VM storage with VM keeper
+	// extended ccstorage module perms are used to execute scripts within tests
+	vmParamStore := params.NewKeeper(input.cdc, input.keyParams, input.tkeyParams).Subspace(vm.DefaultParamspace)
+	vmKeeper := vm.NewKeeper(input.cdc, input.keyVMS, sdk.NewTransientStoreKey(vm.TStoreKey), vmParamStore, nil, nil, config.DefaultVMConfig(),
+		[]vm.KeeperOption{vm.WithVMClient(mockDVM.Client())},
+		func() (moduleName string, modulePerms perms.Permissions) {
+			moduleName = types.ModuleName
+			modulePerms = perms.Permissions{vmClient.PermStorageRead, vmClient.PermStorageWrite, vmClient.PermVmExec}
+			return
+		},
+	)
+	keeper := NewKeeper(input.cdc, input.keyCCStorage, vmKeeper)
+	ctx := input.ctx
+
+	addr := secp256k1.GenPrivKey().PubKey().Address().Bytes()
+	inputs := newBalanceInputs(t, addr)
+	inputs[0].Amount = sdk.NewInt(100)
+
+	resBz, err := types.ResBalance{Value: inputs[0].Amount.BigInt()}.Bytes()
+	require.NoError(t, err)
+
+	// ok: script writes balance
+	{
+		script := []byte{0x1}
+		mockDVM.SetExecResult(script, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: inputs[0].AccessPath, Value: resBz}}, nil)
+		require.NoError(t, vmKeeper.ExecuteScript(ctx, vm.NewMsgExecuteScript(addr, script, nil)))
+
+		balances, err := keeper.GetAccountBalanceResources(ctx, addr)
+		inputs.CheckResources(t, ctx, vmKeeper, balances, err)
+	}
+
+	// ok: script deletes balance
+	{
+		script := []byte{0x2}
+		mockDVM.SetExecResult(script, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Deletion, Path: inputs[0].AccessPath}}, nil)
+		require.NoError(t, vmKeeper.ExecuteScript(ctx, vm.NewMsgExecuteScript(addr, script, nil)))
+
+		inputs[0].Amount = sdk.ZeroInt()
+		balances, err := keeper.GetAccountBalanceResources(ctx, addr)
+		inputs.CheckResources(t, ctx, vmKeeper, balances, err)
+	}
+}
@@ -13,10 +13,16 @@ import (
 	"github.com/dfinance/dnode/x/common_vm"
 )
 
-// GetResStdCurrencyInfo returns VM currencyInfo for stdlib currencies (non-token).
+// GetResStdCurrencyInfo returns VM currencyInfo for stdlib currencies and registered VM-native tokens.
 func (k Keeper) GetResStdCurrencyInfo(ctx sdk.Context, denom string) (types.ResCurrencyInfo, error) {
 	k.modulePerms.AutoCheck(types.PermRead)
 
+	if k.HasCurrency(ctx, denom) {
+		if currency := k.getCurrency(ctx, denom); currency.IsToken() {
+			return k.getResTokenCurrencyInfo(ctx, *currency.VMToken)
+		}
+	}
+
 	accessPath := &vm_grpc.VMAccessPath{
 		Address: common_vm.StdLibAddress,
 		Path:    glav.CurrencyInfoVector(denom),
@@ -35,8 +41,34 @@ func (k Keeper) GetResStdCurrencyInfo(ctx sdk.Context, denom string) (types.ResC
 	return currencyInfo, nil
 }
 
-// storeResStdCurrencyInfo sets currencyInfo to the VM storage.
+// getResTokenCurrencyInfo returns VM currencyInfo for VM-native token (resource is created by VM).
+func (k Keeper) getResTokenCurrencyInfo(ctx sdk.Context, token types.CurrencyVMToken) (types.ResCurrencyInfo, error) {
+	accessPath := &vm_grpc.VMAccessPath{
+		Address: token.VMAddress(),
+		Path:    token.InfoPath(),
+	}
+
+	if !k.vmKeeper.HasValue(ctx, accessPath) {
+		return types.ResCurrencyInfo{}, sdkErrors.Wrapf(types.ErrInternal, "currencyInfo for token %s: nof found in VM storage", token)
+	}
+
+	currencyInfo, err := types.NewResTokenCurrencyInfo(k.vmKeeper.GetValue(ctx, accessPath))
+	if err != nil {
+		return types.ResCurrencyInfo{}, sdkErrors.Wrapf(types.ErrInternal, "currencyInfo for token %s: %v", token, err)
+	}
+	if currencyInfo.TotalSupply == nil {
+		currencyInfo.TotalSupply = sdk.ZeroInt().BigInt()
+	}
+
+	return currencyInfo, nil
+}
+
+// storeResStdCurrencyInfo sets currencyInfo to the VM storage (skipped for VM-native tokens as VM owns the resource).
 func (k Keeper) storeResStdCurrencyInfo(ctx sdk.Context, currency types.Currency) {
+	if currency.IsToken() {
+		return
+	}
+
 	currencyInfo, err := types.NewResCurrencyInfo(currency, common_vm.StdLibAddress)
 	if err != nil {
 		panic(fmt.Errorf("currency %q: %v", currency.Denom, err))
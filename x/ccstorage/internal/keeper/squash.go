@@ -39,6 +39,11 @@ func (k Keeper) PrepareForZeroHeight(ctx sdk.Context, opts SquashOptions) error
 	{
 		if opts.supplyOps.SetToZero {
 			for _, cur := range k.GetCurrencies(ctx) {
+				// VM-native tokens supply is managed by VM
+				if cur.IsToken() {
+					continue
+				}
+
 				cur.Supply = sdk.ZeroInt()
 				k.storeCurrency(ctx, cur)
 				k.storeResStdCurrencyInfo(ctx, cur)
@@ -8,6 +8,7 @@ import (
 	"github.com/dfinance/glav"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/common_vm"
 )
 
 // Currency is an info object with currency params.
@@ -18,6 +19,10 @@ type Currency struct {
 	Decimals uint8 `json:"decimals" yaml:"decimals" example:"0"`
 	// Total amount of currency coins in Bank
 	Supply sdk.Int `json:"supply" yaml:"supply" swaggertype:"string" example:"100"`
+	// Frozen currency can't be issued, withdrawn, transferred and traded
+	Frozen bool `json:"frozen" yaml:"frozen" example:"false"`
+	// VM-native token currency source (nil for standard currencies)
+	VMToken *CurrencyVMToken `json:"vm_token,omitempty" yaml:"vm_token,omitempty"`
 }
 
 // Valid checks that Currency is valid.
@@ -26,9 +31,29 @@ func (c Currency) Valid() error {
 		return fmt.Errorf("denom is invalid: %v", err)
 	}
 
+	if c.VMToken != nil {
+		if err := c.VMToken.Valid(); err != nil {
+			return fmt.Errorf("vm_token is invalid: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// IsToken checks if currency is a VM-native token (supply is managed by VM).
+func (c Currency) IsToken() bool {
+	return c.VMToken != nil
+}
+
+// InfoAddress returns CurrencyInfo resource VM address.
+func (c Currency) InfoAddress() []byte {
+	if c.IsToken() {
+		return c.VMToken.VMAddress()
+	}
+
+	return common_vm.StdLibAddress
+}
+
 // GetSupplyCoin creates sdk.Coin with supply amount.
 func (c Currency) GetSupplyCoin() sdk.Coin {
 	return sdk.NewCoin(c.Denom, c.Supply)
@@ -36,6 +61,10 @@ func (c Currency) GetSupplyCoin() sdk.Coin {
 
 // BalancePath return []byte representation for BalancePath.
 func (c Currency) BalancePath() []byte {
+	if c.IsToken() {
+		return c.VMToken.BalancePath()
+	}
+
 	return glav.BalanceVector(c.Denom)
 }
 
@@ -46,9 +75,22 @@ func (c Currency) BalancePathHex() string {
 
 // InfoPath return []byte representation for InfoPath.
 func (c Currency) InfoPath() []byte {
+	if c.IsToken() {
+		return c.VMToken.InfoPath()
+	}
+
 	return glav.CurrencyInfoVector(c.Denom)
 }
 
+// FrozenInfoPath return []byte representation for 0x1::Dfinance::FrozenInfo<Coin> resource path.
+func (c Currency) FrozenInfoPath() []byte {
+	if c.IsToken() {
+		return c.VMToken.FrozenInfoPath()
+	}
+
+	return glav.NewStructTag(stdLibAddress(), glav.DfinanceModule, FrozenInfoStruct, []glav.TypeParam{stdCurrencyTypeParam(c.Denom)}).AccessVector()
+}
+
 // InfoPathHex return string representation for InfoPath.
 func (c Currency) InfoPathHex() string {
 	return hex.EncodeToString(c.InfoPath())
@@ -72,23 +114,36 @@ func (c Currency) MinDecimal() sdk.Dec {
 }
 
 func (c Currency) String() string {
+	vmToken := "none"
+	if c.IsToken() {
+		vmToken = c.VMToken.String()
+	}
+
 	return fmt.Sprintf("Currency:\n"+
 		"  Denom:    %s\n"+
 		"  Decimals: %d\n"+
-		"  Supply:   %s",
+		"  Supply:   %s\n"+
+		"  Frozen:   %t\n"+
+		"  VMToken:  %s",
 		c.Denom,
 		c.Decimals,
 		c.Supply.String(),
+		c.Frozen,
+		vmToken,
 	)
 }
 
 // Currencies is a slice of Currency objects.
 type Currencies []Currency
 
-// ToParams converts Currencies to CurrenciesParams.
+// ToParams converts Currencies to CurrenciesParams (VM-native tokens are skipped).
 func (list Currencies) ToParams() CurrenciesParams {
 	var params CurrenciesParams
 	for _, currency := range list {
+		if currency.IsToken() {
+			continue
+		}
+
 		params = append(params, CurrencyParams{
 			Denom:    currency.Denom,
 			Decimals: currency.Decimals,
@@ -48,3 +48,38 @@ func TestCCS_Currency_Valid(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid")
 	}
 }
+
+func TestCCS_CurrencyVMToken_Valid(t *testing.T) {
+	t.Parallel()
+
+	token := CurrencyVMToken{
+		Address:    sdk.AccAddress("token_owner_address1"),
+		ModuleName: "MyToken",
+		StructName: "T",
+	}
+
+	// OK
+	{
+		require.NoError(t, token.Valid())
+		require.NoError(t, Currency{Denom: "mytoken", Supply: sdk.ZeroInt(), VMToken: &token}.Valid())
+	}
+
+	// Wrong address
+	{
+		invalidToken := token
+		invalidToken.Address = sdk.AccAddress("short")
+		require.Error(t, invalidToken.Valid())
+		require.Error(t, Currency{Denom: "mytoken", Supply: sdk.ZeroInt(), VMToken: &invalidToken}.Valid())
+	}
+
+	// Empty module and struct names
+	{
+		invalidToken := token
+		invalidToken.ModuleName = ""
+		require.Error(t, invalidToken.Valid())
+
+		invalidToken = token
+		invalidToken.StructName = ""
+		require.Error(t, invalidToken.Valid())
+	}
+}
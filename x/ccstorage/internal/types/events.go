@@ -8,6 +8,7 @@ import (
 
 const (
 	EventTypesCreate = ModuleName + ".create"
+	EventTypesFreeze = ModuleName + ".freeze"
 	//
 	AttributeDenom    = "denom"
 	AttributeDecimals = "decimals"
@@ -23,3 +24,11 @@ func NewCCCreatedEvent(currency Currency) sdk.Event {
 		sdk.NewAttribute(AttributeInfoPath, currency.InfoPathHex()),
 	)
 }
+
+// NewCCFrozenEvent creates an Event on currency freeze.
+func NewCCFrozenEvent(currency Currency) sdk.Event {
+	return sdk.NewEvent(
+		EventTypesFreeze,
+		sdk.NewAttribute(AttributeDenom, currency.Denom),
+	)
+}
@@ -9,6 +9,9 @@ import (
 // GenesisState is module's genesis (initial state).
 type GenesisState struct {
 	CurrenciesParams CurrenciesParams `json:"currencies_params" yaml:"currencies_params"`
+	FrozenDenoms     []string         `json:"frozen_denoms" yaml:"frozen_denoms"`
+	// VM-native token currencies (VM resources must exist: vm genesis is initialized first)
+	VMTokens TokenCurrenciesParams `json:"vm_tokens" yaml:"vm_tokens"`
 }
 
 // Validate checks that genesis state is valid.
@@ -26,6 +29,31 @@ func (s GenesisState) Validate() error {
 		denomsSet[params.Denom] = true
 	}
 
+	for _, params := range s.VMTokens {
+		if denomsSet[params.Denom] {
+			return fmt.Errorf("token params for %q: duplicated", params.Denom)
+		}
+
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("token params for %q: %w", params.Denom, err)
+		}
+
+		denomsSet[params.Denom] = true
+	}
+
+	frozenSet := make(map[string]bool)
+	for _, denom := range s.FrozenDenoms {
+		if frozenSet[denom] {
+			return fmt.Errorf("frozen denom %q: duplicated", denom)
+		}
+
+		if !denomsSet[denom] {
+			return fmt.Errorf("frozen denom %q: currency params not found", denom)
+		}
+
+		frozenSet[denom] = true
+	}
+
 	return nil
 }
 
@@ -77,6 +105,8 @@ func DefaultGenesisState() GenesisState {
 				Decimals: 18,
 			},
 		},
+		FrozenDenoms: []string{},
+		VMTokens:     TokenCurrenciesParams{},
 	}
 
 	return state
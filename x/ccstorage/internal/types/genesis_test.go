@@ -54,6 +54,26 @@ func TestCCS_Genesis_Validate(t *testing.T) {
 		require.NoError(t, state.Validate())
 	}
 
+	// ok: frozen
+	{
+		state.FrozenDenoms = append(state.FrozenDenoms, "btc")
+		require.NoError(t, state.Validate())
+	}
+
+	// fail: frozen duplicate
+	{
+		state.FrozenDenoms = append(state.FrozenDenoms, "btc")
+		require.Error(t, state.Validate())
+		state.FrozenDenoms = state.FrozenDenoms[:1]
+	}
+
+	// fail: frozen non-existing
+	{
+		state.FrozenDenoms = append(state.FrozenDenoms, "eth")
+		require.Error(t, state.Validate())
+		state.FrozenDenoms = state.FrozenDenoms[:1]
+	}
+
 	// fail: duplicate
 	{
 		state.CurrenciesParams = append(state.CurrenciesParams, CurrencyParams{
@@ -3,8 +3,10 @@ package types
 import "bytes"
 
 var (
-	KeyDelimiter      = []byte(":")
-	KeyCurrencyPrefix = []byte("currency")
+	KeyDelimiter              = []byte(":")
+	KeyCurrencyPrefix         = []byte("currency")
+	KeyCurrencyInfoPathPrefix = []byte("currencyInfoPathDenom")
+	KeyFrozenInfoPathPrefix   = []byte("currencyFrozenInfoPathDenom")
 )
 
 // GetCurrencyKey returns Key for storing currency.
@@ -23,6 +25,28 @@ func GetCurrencyKeyPrefix() []byte {
 	return append(KeyCurrencyPrefix, KeyDelimiter...)
 }
 
+// GetCurrencyInfoPathDenomKey returns storage key for standard currency CurrencyInfo VM path to denom index.
+func GetCurrencyInfoPathDenomKey(path []byte) []byte {
+	return bytes.Join(
+		[][]byte{
+			KeyCurrencyInfoPathPrefix,
+			path,
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetFrozenInfoPathDenomKey returns storage key for currency FrozenInfo VM path to denom index.
+func GetFrozenInfoPathDenomKey(path []byte) []byte {
+	return bytes.Join(
+		[][]byte{
+			KeyFrozenInfoPathPrefix,
+			path,
+		},
+		KeyDelimiter,
+	)
+}
+
 // GetCurrencyBalancePathKey returns storage key for currencyBalance VM path.
 func GetCurrencyBalancePathKey(denom string) []byte {
 	return bytes.Join(
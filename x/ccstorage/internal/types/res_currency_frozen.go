@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/dfinance/glav"
+)
+
+const (
+	// Reserved stdlib struct name for the currency frozen state DVM resource (served by the DS middleware)
+	FrozenInfoStruct = "FrozenInfo"
+)
+
+// ResCurrencyFrozenInfo is a DVM resource (0x1::Dfinance::FrozenInfo<Coin>), containing currency frozen state.
+// Resource is not stored to the VM storage, but computed by the DS data middleware (CurrencyInfo layout is not changed).
+type ResCurrencyFrozenInfo struct {
+	// Frozen currency can't be issued, withdrawn, transferred and traded
+	Frozen bool `json:"frozen" yaml:"frozen"`
+}
+
+// NewResCurrencyFrozenInfo converts Currency to VM's ResCurrencyFrozenInfo.
+func NewResCurrencyFrozenInfo(currency Currency) ResCurrencyFrozenInfo {
+	return ResCurrencyFrozenInfo{
+		Frozen: currency.Frozen,
+	}
+}
+
+// stdCurrencyTypeParam returns standard currency Move type param (0x1::XFI::T or 0x1::Coins::{DENOM}).
+func stdCurrencyTypeParam(denom string) glav.TypeParam {
+	denom = strings.ToUpper(denom)
+	if denom == glav.XfiModule {
+		return glav.NewStructTypeParam(glav.NewStructTag(stdLibAddress(), glav.XfiModule, glav.XfiStruct, []glav.TypeParam{}))
+	}
+
+	return glav.NewStructTypeParam(glav.NewStructTag(stdLibAddress(), glav.CoinsModule, denom, []glav.TypeParam{}))
+}
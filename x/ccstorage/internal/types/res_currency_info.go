@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/dfinance/lcs"
+
 	"github.com/dfinance/dnode/x/common_vm"
 )
 
@@ -60,3 +62,13 @@ func NewResCurrencyInfo(currency Currency, ownerAddress []byte) (ResCurrencyInfo
 		TotalSupply: currency.Supply.BigInt(),
 	}, nil
 }
+
+// NewResTokenCurrencyInfo unmarshals lcs representation of VM-native token CurrencyInfo resource.
+func NewResTokenCurrencyInfo(bz []byte) (ResCurrencyInfo, error) {
+	currencyInfo := ResCurrencyInfo{}
+	if err := lcs.Unmarshal(bz, &currencyInfo); err != nil {
+		return ResCurrencyInfo{}, fmt.Errorf("lcs unmarshal: %w", err)
+	}
+
+	return currencyInfo, nil
+}
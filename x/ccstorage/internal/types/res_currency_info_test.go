@@ -3,9 +3,12 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/common_vm"
@@ -55,3 +58,70 @@ func TestCCS_NewCurrencyInfo(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+// Test CurrencyInfo LCS layout matches the stdlib 0x1::Dfinance::Info<T> resource.
+func TestCCS_CurrencyInfoLayout(t *testing.T) {
+	t.Parallel()
+
+	type stdInfo struct {
+		Denom       []byte
+		Decimals    uint8
+		IsToken     bool
+		Owner       []byte `lcs:"len=20"`
+		TotalSupply *big.Int
+	}
+
+	currency := NewCurrency(CurrencyParams{Denom: "test", Decimals: 4}, sdk.NewIntFromUint64(100))
+	currency.Frozen = true
+
+	curInfo, err := NewResCurrencyInfo(currency, common_vm.StdLibAddress)
+	require.NoError(t, err)
+
+	bz, err := lcs.Marshal(curInfo)
+	require.NoError(t, err)
+
+	expectedBz, err := lcs.Marshal(stdInfo{
+		Denom:       []byte("test"),
+		Decimals:    4,
+		Owner:       common_vm.StdLibAddress,
+		TotalSupply: big.NewInt(100),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedBz, bz)
+
+	decodedInfo, err := NewResTokenCurrencyInfo(bz)
+	require.NoError(t, err)
+	require.Equal(t, curInfo, decodedInfo)
+}
+
+// Test currency FrozenInfo resource paths.
+func TestCCS_FrozenInfoPath(t *testing.T) {
+	t.Parallel()
+
+	var stdLibAddr [common_vm.VMAddressLength]byte
+	copy(stdLibAddr[:], common_vm.StdLibAddress)
+	getCoinTag := func(module, name string) glav.TypeParam {
+		return glav.NewStructTypeParam(glav.NewStructTag(stdLibAddr, module, name, []glav.TypeParam{}))
+	}
+	getFrozenInfoPath := func(coinTag glav.TypeParam) []byte {
+		return glav.NewStructTag(stdLibAddr, glav.DfinanceModule, FrozenInfoStruct, []glav.TypeParam{coinTag}).AccessVector()
+	}
+
+	// ok: standard currencies
+	{
+		xfi := NewCurrency(CurrencyParams{Denom: "xfi", Decimals: 18}, sdk.ZeroInt())
+		require.Equal(t, getFrozenInfoPath(getCoinTag(glav.XfiModule, glav.XfiStruct)), xfi.FrozenInfoPath())
+
+		btc := NewCurrency(CurrencyParams{Denom: "btc", Decimals: 8}, sdk.ZeroInt())
+		require.Equal(t, getFrozenInfoPath(getCoinTag(glav.CoinsModule, "BTC")), btc.FrozenInfoPath())
+		require.NotEqual(t, btc.InfoPath(), btc.FrozenInfoPath())
+	}
+
+	// ok: VM token
+	{
+		token := CurrencyVMToken{Address: sdk.AccAddress(common_vm.StdLibAddress), ModuleName: "MyToken", StructName: "T"}
+		currency := NewCurrency(CurrencyParams{Denom: "mytoken", Decimals: 6}, sdk.ZeroInt())
+		currency.VMToken = &token
+		require.Equal(t, getFrozenInfoPath(getCoinTag("MyToken", "T")), currency.FrozenInfoPath())
+	}
+}
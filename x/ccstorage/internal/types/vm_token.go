@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/glav"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// CurrencyVMToken defines VM-native token currency (Move module struct tokenized via 0x1::Dfinance::tokenize).
+type CurrencyVMToken struct {
+	// Token module owner address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Token module name
+	ModuleName string `json:"module_name" yaml:"module_name" example:"MyToken"`
+	// Token struct name
+	StructName string `json:"struct_name" yaml:"struct_name" example:"T"`
+}
+
+// Valid checks that CurrencyVMToken is valid.
+func (t CurrencyVMToken) Valid() error {
+	if len(t.Address) != common_vm.VMAddressLength {
+		return fmt.Errorf("address: length mismatch: %d / %d", len(t.Address), common_vm.VMAddressLength)
+	}
+
+	if t.ModuleName == "" {
+		return fmt.Errorf("module_name: empty")
+	}
+
+	if t.StructName == "" {
+		return fmt.Errorf("struct_name: empty")
+	}
+
+	return nil
+}
+
+// VMAddress returns token owner VM address.
+func (t CurrencyVMToken) VMAddress() []byte {
+	return common_vm.Bech32ToLibra(t.Address)
+}
+
+// BalancePath returns 0x1::Account::Balance<{token}> resource path.
+func (t CurrencyVMToken) BalancePath() []byte {
+	return glav.NewStructTag(stdLibAddress(), glav.AccountModule, glav.BalanceStruct, []glav.TypeParam{t.typeParam()}).AccessVector()
+}
+
+// InfoPath returns 0x1::Dfinance::Info<{token}> resource path.
+func (t CurrencyVMToken) InfoPath() []byte {
+	return glav.NewStructTag(stdLibAddress(), glav.DfinanceModule, glav.InfoStruct, []glav.TypeParam{t.typeParam()}).AccessVector()
+}
+
+// FrozenInfoPath returns 0x1::Dfinance::FrozenInfo<{token}> resource path.
+func (t CurrencyVMToken) FrozenInfoPath() []byte {
+	return glav.NewStructTag(stdLibAddress(), glav.DfinanceModule, FrozenInfoStruct, []glav.TypeParam{t.typeParam()}).AccessVector()
+}
+
+func (t CurrencyVMToken) String() string {
+	return fmt.Sprintf("%s::%s::%s", t.Address.String(), t.ModuleName, t.StructName)
+}
+
+// typeParam returns {address}::{module}::{struct} type parameter.
+func (t CurrencyVMToken) typeParam() glav.TypeParam {
+	var address [common_vm.VMAddressLength]byte
+	copy(address[:], t.VMAddress())
+
+	return glav.NewStructTypeParam(glav.NewStructTag(address, t.ModuleName, t.StructName, []glav.TypeParam{}))
+}
+
+// TokenCurrencyParams defines VM-native token currency genesis params and currency registration params.
+type TokenCurrencyParams struct {
+	// Denomination symbol
+	Denom string `json:"denom" yaml:"denom"`
+	// VM token
+	Token CurrencyVMToken `json:"token" yaml:"token"`
+}
+
+// Validate check that params are valid.
+func (p TokenCurrencyParams) Validate() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	if err := p.Token.Valid(); err != nil {
+		return fmt.Errorf("token: %w", err)
+	}
+
+	return nil
+}
+
+// TokenCurrenciesParams slice of TokenCurrencyParams objects.
+type TokenCurrenciesParams []TokenCurrencyParams
+
+// stdLibAddress converts std lib address to glav format.
+func stdLibAddress() [common_vm.VMAddressLength]byte {
+	var address [common_vm.VMAddressLength]byte
+	copy(address[:], common_vm.StdLibAddress)
+
+	return address
+}
@@ -55,6 +55,18 @@ func GetPathPrefixKey() []byte {
 	return append(VMKey, KeyDelimiter...)
 }
 
+// GetAddressPathPrefixKey returns storage key prefix for VM values of the address (used for iteration).
+func GetAddressPathPrefixKey(address []byte) []byte {
+	return bytes.Join(
+		[][]byte{
+			VMKey,
+			address,
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
 // MustParsePathKey parses VM storage key and panics on failure.
 func MustParsePathKey(key []byte) *vm_grpc.VMAccessPath {
 	accessPath := vm_grpc.VMAccessPath{}
@@ -6,18 +6,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/dfinance/dnode/x/ccstorage"
+	"github.com/dfinance/dnode/x/vm"
 	"github.com/dfinance/dnode/x/vmauth"
 )
 
 // NewAnteHandler return custom AnteHandler.
-// Adds DenomDecorator and uses standard decorators (standard AnteHandler).
+// Adds DenomDecorator, FrozenDenomDecorator, VMAvailabilityDecorator and uses standard decorators (standard AnteHandler).
 // Some decorators are a copy of 'github.com/cosmos/cosmos-sdk/x/auth/ante' decorators, but using vmauth.VMAccountKeeper.
-func NewAnteHandler(ak vmauth.Keeper, supplyKeeper types.SupplyKeeper, sigGasConsumer auth.SignatureVerificationGasConsumer) sdk.AnteHandler {
+func NewAnteHandler(ak vmauth.Keeper, supplyKeeper types.SupplyKeeper, ccsKeeper ccstorage.Keeper, vmKeeper vm.Keeper, sigGasConsumer auth.SignatureVerificationGasConsumer) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewDenomDecorator(),
 		ante.NewSetUpContextDecorator(),
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
+		NewFrozenDenomDecorator(ccsKeeper),
+		NewVMAvailabilityDecorator(vmKeeper),
 		ante.NewValidateMemoDecorator(ak.AccountKeeper),      // as is: only uses ak.GetParams()
 		NewConsumeGasForTxSizeDecorator(ak),                  // copy: uses ak.GetAccount()
 		NewSetPubKeyDecorator(ak),                            // copy: uses ak.GetAccount()
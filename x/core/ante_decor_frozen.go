@@ -0,0 +1,50 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/dfinance/dnode/x/ccstorage"
+)
+
+// FrozenDenomDecorator catches and prevents bank transfers of frozen currencies.
+type FrozenDenomDecorator struct {
+	ccsKeeper ccstorage.Keeper
+}
+
+func NewFrozenDenomDecorator(ccsKeeper ccstorage.Keeper) FrozenDenomDecorator {
+	return FrozenDenomDecorator{
+		ccsKeeper: ccsKeeper,
+	}
+}
+
+func (fd FrozenDenomDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			if err := fd.checkCoins(ctx, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case bank.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := fd.checkCoins(ctx, input.Coins); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkCoins checks that {coins} have no frozen denoms.
+func (fd FrozenDenomDecorator) checkCoins(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if fd.ccsKeeper.IsCurrencyFrozen(ctx, coin.Denom) {
+			return sdkErrors.Wrapf(ErrFrozenDenom, "%q", coin.Denom)
+		}
+	}
+
+	return nil
+}
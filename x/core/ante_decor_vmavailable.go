@@ -0,0 +1,39 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/vm"
+)
+
+// VMAvailabilityDecorator rejects VM Txs on CheckTx while VM is unavailable (VM connection health check failed).
+// Returned error is retryable, so clients can resend the Tx later.
+// ReCheckTx is skipped to keep mempool Txs until VM is restored.
+type VMAvailabilityDecorator struct {
+	vmKeeper vm.Keeper
+}
+
+func NewVMAvailabilityDecorator(vmKeeper vm.Keeper) VMAvailabilityDecorator {
+	return VMAvailabilityDecorator{
+		vmKeeper: vmKeeper,
+	}
+}
+
+func (vd VMAvailabilityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if msg.Route() != vm.RouterKey {
+			continue
+		}
+
+		if err := vd.vmKeeper.CheckVMAvailability(); err != nil {
+			return ctx, err
+		}
+		break
+	}
+
+	return next(ctx, tx, simulate)
+}
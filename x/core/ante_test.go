@@ -6,11 +6,15 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestTypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/mock"
@@ -21,8 +25,14 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/x/ccstorage"
+	ccsClient "github.com/dfinance/dnode/x/ccstorage/client"
 	"github.com/dfinance/dnode/x/vm"
+	vmClient "github.com/dfinance/dnode/x/vm/client"
 	"github.com/dfinance/dnode/x/vmauth"
 )
 
@@ -57,6 +67,7 @@ func setupTestInput() testInput {
 	ccsKey := sdk.NewKVStoreKey(ccstorage.StoreKey)
 	accKey := sdk.NewKVStoreKey(authTypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	tkeyVM := sdk.NewTransientStoreKey(vm.TStoreKey)
 
 	// init in-memory DB
 	db := dbm.NewMemDB()
@@ -66,6 +77,7 @@ func setupTestInput() testInput {
 	ms.MountStoreWithDB(ccsKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(accKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(tkeyVM, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
 
 	// create target and dependant keepers
@@ -73,16 +85,26 @@ func setupTestInput() testInput {
 	input.vmStorage = vm.NewKeeper(
 		input.cdc,
 		vmKey,
+		tkeyVM,
+		input.paramsKeeper.Subspace(vm.DefaultParamspace),
+		nil,
 		nil,
 		nil,
 		nil,
 		ccstorage.RequestVMStoragePerms(),
+		RequestVMPerms(),
 	)
 	input.ccsStorage = ccstorage.NewKeeper(
 		input.cdc,
 		ccsKey,
 		input.vmStorage,
 		vmauth.RequestCCStoragePerms(),
+		// extended core module perms are used to freeze currencies within tests
+		func() (moduleName string, modulePerms perms.Permissions) {
+			moduleName = Codespace
+			modulePerms = perms.Permissions{ccsClient.PermRead, ccsClient.PermUpdate}
+			return
+		},
 	)
 	input.accKeeper = vmauth.NewKeeper(input.cdc, accKey, input.paramsKeeper.Subspace(auth.DefaultParamspace), input.ccsStorage, authTypes.ProtoBaseAccount)
 	input.supplyKeeper = mock.NewDummySupplyKeeper(input.accKeeper.AccountKeeper)
@@ -131,7 +153,7 @@ func TestAnteHandler_WrongZeroFee(t *testing.T) {
 	privs, accNums, seqs := []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}
 	tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
 
-	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, input.ccsStorage, input.vmStorage, auth.DefaultSigVerificationGasConsumer)
 	checkInvalidTx(t, ah, input.ctx, tx, true, ErrFeeRequired)
 }
 
@@ -157,7 +179,7 @@ func TestAnteHandler_WrongFeeDenom(t *testing.T) {
 	privs, accNums, seqs := []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}
 	tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
 
-	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, input.ccsStorage, input.vmStorage, auth.DefaultSigVerificationGasConsumer)
 	checkInvalidTx(t, ah, input.ctx, tx, true, ErrWrongFeeDenom)
 }
 
@@ -183,6 +205,175 @@ func TestAnteHandler_CorrectDenomFees(t *testing.T) {
 	privs, accNums, seqs := []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}
 	tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
 
-	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, input.ccsStorage, input.vmStorage, auth.DefaultSigVerificationGasConsumer)
 	checkValidTx(t, ah, input.ctx, tx, true)
 }
+
+// nolint:errcheck
+// Test bank transfers with frozen currency.
+func TestAnteHandler_FrozenDenom(t *testing.T) {
+	t.Parallel()
+
+	input := setupTestInput()
+
+	priv, _, addr := vestTypes.KeyTestPubAddr()
+	_, _, recipientAddr := vestTypes.KeyTestPubAddr()
+	acc := input.accKeeper.NewAccountWithAddress(input.ctx, addr)
+
+	transferCoins := sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1)))
+	acc.SetCoins(DefaultFees.Add(transferCoins...))
+	input.accKeeper.SetAccount(input.ctx, acc)
+
+	fee := auth.StdFee{Gas: 100000, Amount: DefaultFees}
+	privs, accNums, seqs := []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, input.ccsStorage, input.vmStorage, auth.DefaultSigVerificationGasConsumer)
+
+	sendMsg := bank.NewMsgSend(addr, recipientAddr, transferCoins)
+	multiSendMsg := bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(addr, transferCoins)},
+		[]bank.Output{bank.NewOutput(recipientAddr, transferCoins)},
+	)
+
+	// ok: currency is not frozen
+	{
+		tx := authTypes.NewTestTx(input.ctx, []sdk.Msg{sendMsg}, privs, accNums, seqs, fee)
+		checkValidTx(t, ah, input.ctx, tx, true)
+	}
+
+	require.NoError(t, input.ccsStorage.FreezeCurrency(input.ctx, "btc"))
+
+	// fail: MsgSend
+	{
+		tx := authTypes.NewTestTx(input.ctx, []sdk.Msg{sendMsg}, privs, accNums, seqs, fee)
+		checkInvalidTx(t, ah, input.ctx, tx, true, ErrFrozenDenom)
+	}
+
+	// fail: MsgMultiSend
+	{
+		tx := authTypes.NewTestTx(input.ctx, []sdk.Msg{multiSendMsg}, privs, accNums, seqs, fee)
+		checkInvalidTx(t, ah, input.ctx, tx, true, ErrFrozenDenom)
+	}
+}
+
+// nolint:errcheck
+// Test multi-signer VM script signatures verification.
+func TestAnteHandler_MultiSignerScript(t *testing.T) {
+	t.Parallel()
+
+	input := setupTestInput()
+
+	priv1, _, addr1 := vestTypes.KeyTestPubAddr()
+	priv2, _, addr2 := vestTypes.KeyTestPubAddr()
+	acc1 := input.accKeeper.NewAccountWithAddress(input.ctx, addr1)
+	acc1.SetCoins(DefaultFees)
+	input.accKeeper.SetAccount(input.ctx, acc1)
+	acc2 := input.accKeeper.NewAccountWithAddress(input.ctx, addr2)
+	input.accKeeper.SetAccount(input.ctx, acc2)
+
+	msg := vm.NewMsgExecuteScript(addr1, make([]byte, 128), nil)
+	msg.CoSigners = []sdk.AccAddress{addr2}
+	msgs := []sdk.Msg{msg}
+
+	fee := auth.StdFee{Gas: 1000000, Amount: DefaultFees}
+	accNums, seqs := []uint64{acc1.GetAccountNumber(), acc2.GetAccountNumber()}, []uint64{0, 0}
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, input.ccsStorage, input.vmStorage, auth.DefaultSigVerificationGasConsumer)
+
+	// fail: co-signer signature is missing
+	{
+		tx := authTypes.NewTestTx(input.ctx, msgs, []crypto.PrivKey{priv1}, accNums[:1], seqs[:1], fee)
+		checkInvalidTx(t, ah, input.ctx, tx, false, sdkErrors.ErrUnauthorized)
+	}
+
+	// fail: wrong signatures order
+	{
+		tx := authTypes.NewTestTx(input.ctx, msgs, []crypto.PrivKey{priv2, priv1}, []uint64{accNums[1], accNums[0]}, seqs, fee)
+		checkInvalidTx(t, ah, input.ctx, tx, false, sdkErrors.ErrUnauthorized)
+	}
+
+	// ok
+	{
+		tx := authTypes.NewTestTx(input.ctx, msgs, []crypto.PrivKey{priv1, priv2}, accNums, seqs, fee)
+		checkValidTx(t, ah, input.ctx, tx, false)
+	}
+}
+
+// nolint:errcheck
+// Test VM Txs rejection on CheckTx while VM is unavailable.
+func TestAnteHandler_VMUnavailable(t *testing.T) {
+	t.Parallel()
+
+	input := setupTestInput()
+
+	// start mockDVM and VM keeper with connection health checks
+	listenerAddr, _, err := server.FreeTCPAddr()
+	require.NoError(t, err)
+	mockDvmListener, err := helpers.GetGRpcNetListener(listenerAddr)
+	require.NoError(t, err)
+	mockDvmServer := mockdvm.StartMockDVMService(mockDvmListener)
+	defer mockDvmServer.Stop()
+
+	mockDvmConn, err := helpers.GetGRpcClientConnection(listenerAddr, 0)
+	require.NoError(t, err)
+
+	vmConfig := config.DefaultVMConfig()
+	vmConfig.HealthCheckPeriodInMs, vmConfig.HealthCheckTimeoutInMs = 50, 50
+	vmConfig.RetryInitialBackoffInMs, vmConfig.RetryMaxBackoffInMs = 10, 50
+
+	// extended core module perms are used to start / stop health checks within tests
+	vmParamStore := params.NewKeeper(input.cdc, sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)).Subspace(vm.DefaultParamspace)
+	vmKeeper := vm.NewKeeper(input.cdc, sdk.NewKVStoreKey(vm.StoreKey), sdk.NewTransientStoreKey(vm.TStoreKey), vmParamStore, mockDvmConn, nil, vmConfig, nil,
+		func() (moduleName string, modulePerms perms.Permissions) {
+			moduleName = Codespace
+			modulePerms = perms.Permissions{vmClient.PermVmExec, vmClient.PermDsAdmin}
+			return
+		},
+	)
+	vmKeeper.StartConnMonitor(input.ctx)
+	defer vmKeeper.CloseConnections()
+
+	_, _, addr := vestTypes.KeyTestPubAddr()
+	vmTx := authTypes.NewTestTx(input.ctx, []sdk.Msg{vm.NewMsgExecuteScript(addr, make([]byte, 128), nil)}, nil, nil, nil, auth.StdFee{})
+	nonVMTx := authTypes.NewTestTx(input.ctx, []sdk.Msg{vestTypes.NewTestMsg(addr)}, nil, nil, nil, auth.StdFee{})
+
+	checkCtx := input.ctx.WithIsCheckTx(true)
+	recheckCtx := checkCtx.WithIsReCheckTx(true)
+	deliverCtx := input.ctx
+
+	decorator := NewVMAvailabilityDecorator(vmKeeper)
+	ah := func(ctx sdk.Context, tx sdk.Tx) error {
+		_, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// ok: VM is available
+	{
+		require.NoError(t, ah(checkCtx, vmTx))
+	}
+
+	mockDvmServer.SetUnavailable()
+
+	// fail: VM Tx on CheckTx
+	{
+		require.Eventually(t, func() bool {
+			return vm.ErrVMUnavailable.Is(ah(checkCtx, vmTx))
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// ok: non-VM Tx, ReCheckTx, DeliverTx
+	{
+		require.NoError(t, ah(checkCtx, nonVMTx))
+		require.NoError(t, ah(recheckCtx, vmTx))
+		require.NoError(t, ah(deliverCtx, vmTx))
+	}
+
+	mockDvmServer.SetAvailable()
+
+	// ok: VM is restored
+	{
+		require.Eventually(t, func() bool {
+			return ah(checkCtx, vmTx) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"github.com/dfinance/dnode/helpers/perms"
+	ccsClient "github.com/dfinance/dnode/x/ccstorage/client"
+	vmClient "github.com/dfinance/dnode/x/vm/client"
+)
+
+// RequestCCStoragePerms returns module perms used by this module.
+func RequestCCStoragePerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = Codespace
+		modulePerms = perms.Permissions{
+			ccsClient.PermRead,
+		}
+		return
+	}
+}
+
+// RequestVMPerms returns module perms used by this module.
+func RequestVMPerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = Codespace
+		modulePerms = perms.Permissions{
+			vmClient.PermVmExec,
+		}
+		return
+	}
+}
@@ -6,19 +6,29 @@ import (
 )
 
 type (
-	Keeper              = keeper.Keeper
-	GenesisState        = types.GenesisState
-	Issue               = types.Issue
-	Withdraw            = types.Withdraw
-	Withdraws           = types.Withdraws
-	MsgIssueCurrency    = types.MsgIssueCurrency
-	MsgWithdrawCurrency = types.MsgWithdrawCurrency
-	MsgUnstakeCurrency  = types.MsgUnstakeCurrency
-	AddCurrencyProposal = types.AddCurrencyProposal
-	CurrencyReq         = types.CurrencyReq
-	IssueReq            = types.IssueReq
-	WithdrawsReq        = types.WithdrawsReq
-	WithdrawReq         = types.WithdrawReq
+	Keeper                   = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Issue                    = types.Issue
+	Withdraw                 = types.Withdraw
+	Withdraws                = types.Withdraws
+	MsgIssueCurrency         = types.MsgIssueCurrency
+	MsgWithdrawCurrency      = types.MsgWithdrawCurrency
+	MsgUnstakeCurrency       = types.MsgUnstakeCurrency
+	MsgIssueCurrencyBatch    = types.MsgIssueCurrencyBatch
+	IssueBatchEntry          = types.IssueBatchEntry
+	MsgUnbanAccount          = types.MsgUnbanAccount
+	Unstake                  = types.Unstake
+	Unban                    = types.Unban
+	BannedAccount            = types.BannedAccount
+	BannedAccounts           = types.BannedAccounts
+	UnstakeReq               = types.UnstakeReq
+	AddCurrencyProposal      = types.AddCurrencyProposal
+	FreezeCurrencyProposal   = types.FreezeCurrencyProposal
+	AddTokenCurrencyProposal = types.AddTokenCurrencyProposal
+	CurrencyReq              = types.CurrencyReq
+	IssueReq                 = types.IssueReq
+	WithdrawsReq             = types.WithdrawsReq
+	WithdrawReq              = types.WithdrawReq
 )
 
 const (
@@ -31,6 +41,11 @@ const (
 	QueryWithdraw  = types.QueryWithdraw
 	QueryIssue     = types.QueryIssue
 	QueryCurrency  = types.QueryCurrency
+	QueryUnstake   = types.QueryUnstake
+	QueryUnban     = types.QueryUnban
+	QueryBanned    = types.QueryBanned
+	//
+	IssueBatchMaxEntries = types.IssueBatchMaxEntries
 	// Event types, attribute types and values
 	EventTypesIssue    = types.EventTypesIssue
 	EventTypesWithdraw = types.EventTypesWithdraw
@@ -47,14 +62,18 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// function aliases
-	RegisterCodec          = types.RegisterCodec
-	NewKeeper              = keeper.NewKeeper
-	NewQuerier             = keeper.NewQuerier
-	DefaultGenesisState    = types.DefaultGenesisState
-	RegisterInvariants     = keeper.RegisterInvariants
-	NewMsgIssueCurrency    = types.NewMsgIssueCurrency
-	NewMsgWithdrawCurrency = types.NewMsgWithdrawCurrency
-	NewAddCurrencyProposal = types.NewAddCurrencyProposal
+	RegisterCodec               = types.RegisterCodec
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	DefaultGenesisState         = types.DefaultGenesisState
+	RegisterInvariants          = keeper.RegisterInvariants
+	NewMsgIssueCurrency         = types.NewMsgIssueCurrency
+	NewMsgWithdrawCurrency      = types.NewMsgWithdrawCurrency
+	NewMsgIssueCurrencyBatch    = types.NewMsgIssueCurrencyBatch
+	NewMsgUnbanAccount          = types.NewMsgUnbanAccount
+	NewAddCurrencyProposal      = types.NewAddCurrencyProposal
+	NewFreezeCurrencyProposal   = types.NewFreezeCurrencyProposal
+	NewAddTokenCurrencyProposal = types.NewAddTokenCurrencyProposal
 	// perms requests
 	RequestCCStoragePerms = types.RequestCCStoragePerms
 	// errors
@@ -65,8 +84,9 @@ var (
 	ErrWrongWithdrawID     = types.ErrWrongWithdrawID
 	ErrWrongPegZoneSpender = types.ErrWrongPegZonePayee
 	ErrGovInvalidProposal  = types.ErrGovInvalidProposal
-
-	// Mint denom and event type when mint happen.
-	MintDenom = types.MintDenom
-	MintEvent = types.MintEventType
+	ErrFrozenDenom         = types.ErrFrozenDenom
+	ErrWrongIssueBatch     = types.ErrWrongIssueBatch
+	ErrWrongUnstakeID      = types.ErrWrongUnstakeID
+	ErrAccountNotBanned    = types.ErrAccountNotBanned
+	ErrVMTokenDenom        = types.ErrVMTokenDenom
 )
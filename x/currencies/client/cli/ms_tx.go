@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -54,6 +55,51 @@ func PostMsUnstakeCurrency(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// PostMsUnbanAccount returns tx command which post a new multisig unban request.
+func PostMsUnbanAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-unban [unbanID] [account]",
+		Short:   "Unban account banned for staking operations via multi signature",
+		Example: "ms-unban unban1 {account} --from {account}",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			account, err := helpers.ParseSdkAddressParam("account", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgUnbanAccount(args[0], account)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique multi signature call ID",
+		"account address to unban",
+	})
+
+	return cmd
+}
+
 // PostMsIssueCurrency returns tx command which post a new multisig issue request.
 func PostMsIssueCurrency(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -104,3 +150,52 @@ func PostMsIssueCurrency(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// PostMsIssueCurrencyBatch returns tx command which post a new multisig batch issue request.
+func PostMsIssueCurrencyBatch(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-issue-batch [json_file]",
+		Short:   "Issue currencies in batch via multi signature, increasing payees coin balances",
+		Example: "ms-issue-batch ./issues.json --from {account}",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			entriesBz, err := helpers.ParseFilePath("json_file", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			var entries []types.IssueBatchEntry
+			if err := cdc.UnmarshalJSON(entriesBz, &entries); err != nil {
+				return helpers.BuildError("json_file", args[0], helpers.ParamTypeCliArg, fmt.Sprintf("JSON unmarshal: %v", err))
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgIssueCurrencyBatch(entries)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			callMsg := msClient.NewMsgSubmitCall(msg, msg.CallUniqueID(), fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		fmt.Sprintf(`path to JSON file with issues list (%d entries max): [{"id": "issue1", "coin": {"denom": "xfi", "amount": "100"}, "payee": "{account}"}, ...]`, types.IssueBatchMaxEntries),
+	})
+
+	return cmd
+}
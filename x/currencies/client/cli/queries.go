@@ -200,3 +200,102 @@ func GetWithdraw(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetUnstake returns query command that returns unstake by ID.
+func GetUnstake(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unstake [unstakeID]",
+		Short:   "Get unstake by ID",
+		Example: "unstake unstake1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// prepare request
+			req := types.UnstakeReq{ID: args[0]}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUnstake), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Unstake
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique unstake ID",
+	})
+
+	return cmd
+}
+
+// GetUnban returns query command that returns unban by ID.
+func GetUnban(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unban [unbanID]",
+		Short:   "Get account unban by ID",
+		Example: "unban unban1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// prepare request
+			req := types.UnbanReq{ID: args[0]}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUnban), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Unban
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique unban ID",
+	})
+
+	return cmd
+}
+
+// GetBannedAccounts returns query command that returns all accounts banned for staking operations.
+func GetBannedAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "banned",
+		Short:   "Get all accounts banned for staking operations",
+		Example: "banned",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryBanned), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.BannedAccounts
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
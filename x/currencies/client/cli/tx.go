@@ -120,3 +120,107 @@ func AddCurrencyProposal(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// Send governance add VM-native token currency proposal.
+func AddTokenCurrencyProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-token-currency-proposal [denom] [moduleAddress] [moduleName] [structName]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit VM-native token currency add proposal, registering Move module token as a currency",
+		Example: "add-token-currency-proposal mytoken {account} MyToken T --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			moduleAddr, err := helpers.ParseSdkAddressParam("moduleAddress", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewAddTokenCurrencyProposal(denom, moduleAddr, args[2], args[3])
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"new currency denomination symbol",
+		"token module owner address",
+		"token module name",
+		"token struct name",
+	})
+
+	return cmd
+}
+
+// Send governance freeze currency proposal.
+func FreezeCurrencyProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freeze-currency-proposal [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit currency freeze proposal, blocking currency issue, withdraw, transfers and DEX operations",
+		Example: "freeze-currency-proposal btc --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewFreezeCurrencyProposal(denom)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol to freeze",
+	})
+
+	return cmd
+}
@@ -23,6 +23,9 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 			cli.GetCurrencies(types.ModuleName, cdc),
 			cli.GetWithdraw(types.ModuleName, cdc),
 			cli.GetWithdraws(types.ModuleName, cdc),
+			cli.GetUnstake(types.ModuleName, cdc),
+			cli.GetUnban(types.ModuleName, cdc),
+			cli.GetBannedAccounts(types.ModuleName, cdc),
 		)...)
 
 	return queryCmd
@@ -37,9 +40,13 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.PostMsIssueCurrency(cdc),
+		cli.PostMsIssueCurrencyBatch(cdc),
 		cli.PostMsUnstakeCurrency(cdc),
+		cli.PostMsUnbanAccount(cdc),
 		cli.PostWithdrawCurrency(cdc),
 		cli.AddCurrencyProposal(cdc),
+		cli.AddTokenCurrencyProposal(cdc),
+		cli.FreezeCurrencyProposal(cdc),
 	)...)
 
 	return txCmd
@@ -22,6 +22,10 @@ func NewGovHandler(k Keeper) gov.Handler {
 		switch p := c.(type) {
 		case AddCurrencyProposal:
 			return handleAddCurrencyProposal(ctx, k, p)
+		case FreezeCurrencyProposal:
+			return handleFreezeCurrencyProposal(ctx, k, p)
+		case AddTokenCurrencyProposal:
+			return handleAddTokenCurrencyProposal(ctx, k, p)
 		default:
 			return fmt.Errorf("unsupported proposal content type %q for module %q", c.ProposalType(), ModuleName)
 		}
@@ -43,3 +47,33 @@ func handleAddCurrencyProposal(ctx sdk.Context, k Keeper, p AddCurrencyProposal)
 
 	return nil
 }
+
+// handleFreezeCurrencyProposal handles currency freeze proposal.
+func handleFreezeCurrencyProposal(ctx sdk.Context, k Keeper, p FreezeCurrencyProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.FreezeCurrency(ctx, p.Denom); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "freezing currency: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
+
+// handleAddTokenCurrencyProposal handles VM-native token currency registration proposal.
+func handleAddTokenCurrencyProposal(ctx sdk.Context, k Keeper, p AddTokenCurrencyProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.CreateTokenCurrency(ctx, p.GetTokenCurrencyParams()); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "creating token currency: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
@@ -18,13 +18,19 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/helpers/perms"
 	"github.com/dfinance/dnode/helpers/tests"
+	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/currencies/internal/types"
 	"github.com/dfinance/dnode/x/multisig"
 	"github.com/dfinance/dnode/x/poa"
 	"github.com/dfinance/dnode/x/vm"
+	vmClient "github.com/dfinance/dnode/x/vm/client"
+	"github.com/dfinance/dnode/x/vmauth"
+	"github.com/dfinance/dnode/x/vmsupply"
 )
 
 const (
@@ -53,15 +59,18 @@ type TestInput struct {
 	keyVMS     *sdk.KVStoreKey
 	keyStaking *sdk.KVStoreKey
 	//
-	accountKeeper auth.AccountKeeper
-	bankKeeper    bank.Keeper
-	supplyKeeper  supply.Keeper
-	paramsKeeper  params.Keeper
-	ccsStorage    ccstorage.Keeper
-	stakingKeeper staking.Keeper
-	keeper        Keeper
+	accountKeeper   auth.AccountKeeper
+	vmAccountKeeper vmauth.Keeper
+	bankKeeper      bank.Keeper
+	supplyKeeper    vmsupply.Keeper
+	paramsKeeper    params.Keeper
+	ccsStorage      ccstorage.Keeper
+	stakingKeeper   staking.Keeper
+	keeper          Keeper
 	//
 	vmStorage common_vm.VMStorage
+	vmKeeper  vm.Keeper
+	mockDVM   *mockdvm.MockDVM
 }
 
 func (input *TestInput) CreateAccount(t *testing.T, accName string, coins sdk.Coins) (accAddress sdk.AccAddress) {
@@ -79,7 +88,17 @@ func (input *TestInput) CreateAccount(t *testing.T, accName string, coins sdk.Co
 	return addr
 }
 
+// NewTestInput creates test input with the test VM storage.
 func NewTestInput(t *testing.T) TestInput {
+	return newTestInput(t, false)
+}
+
+// NewMockDVMTestInput creates test input with the VM keeper using the in-process mockDVM backend.
+func NewMockDVMTestInput(t *testing.T) TestInput {
+	return newTestInput(t, true)
+}
+
+func newTestInput(t *testing.T, withMockDVM bool) TestInput {
 	input := TestInput{
 		cdc:        codec.New(),
 		keyParams:  sdk.NewKVStoreKey(params.StoreKey),
@@ -122,23 +141,61 @@ func NewTestInput(t *testing.T) TestInput {
 		t.Fatal(err)
 	}
 
-	// create test VM storage
-	input.vmStorage = tests.NewVMStorage(input.keyVMS)
-
 	// create target and dependant keepers
 	input.paramsKeeper = params.NewKeeper(input.cdc, input.keyParams, input.tkeyParams)
-	input.accountKeeper = auth.NewAccountKeeper(input.cdc, input.keyAccount, input.paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
-	input.bankKeeper = bank.NewBaseKeeper(input.accountKeeper, input.paramsKeeper.Subspace(bank.DefaultParamspace), tests.ModuleAccountAddrs())
-	input.supplyKeeper = supply.NewKeeper(input.cdc, input.keySupply, input.accountKeeper, input.bankKeeper, tests.MAccPerms)
+
+	// create test VM storage or VM keeper with mockDVM backend
+	// extended currencies module perms are used to execute scripts within tests
+	if withMockDVM {
+		input.mockDVM = mockdvm.NewMockDVM()
+		input.vmKeeper = vm.NewKeeper(
+			input.cdc,
+			input.keyVMS,
+			sdk.NewTransientStoreKey(vm.TStoreKey),
+			input.paramsKeeper.Subspace(vm.DefaultParamspace),
+			nil,
+			nil,
+			config.DefaultVMConfig(),
+			[]vm.KeeperOption{vm.WithVMClient(input.mockDVM.Client())},
+			ccstorage.RequestVMStoragePerms(),
+			func() (moduleName string, modulePerms perms.Permissions) {
+				moduleName = types.ModuleName
+				modulePerms = perms.Permissions{vmClient.PermStorageRead, vmClient.PermVmExec}
+				return
+			},
+		)
+		input.vmStorage = input.vmKeeper
+	} else {
+		input.vmStorage = tests.NewVMStorage(input.keyVMS)
+	}
+
 	input.ccsStorage = ccstorage.NewKeeper(
 		input.cdc,
 		input.keyCCS,
 		input.vmStorage,
 		types.RequestCCStoragePerms(),
+		vmauth.RequestCCStoragePerms(),
+		vmsupply.RequestCCStoragePerms(),
 	)
+	input.vmAccountKeeper = vmauth.NewKeeper(input.cdc, input.keyAccount, input.paramsKeeper.Subspace(auth.DefaultParamspace), input.ccsStorage, auth.ProtoBaseAccount)
+	input.accountKeeper = input.vmAccountKeeper.AccountKeeper
+	if withMockDVM {
+		// VM account keeper is used (as within the app) to sync account balances with VM resources
+		input.bankKeeper = bank.NewBaseKeeper(input.vmAccountKeeper, input.paramsKeeper.Subspace(bank.DefaultParamspace), tests.ModuleAccountAddrs())
+		input.supplyKeeper = vmsupply.NewKeeper(
+			supply.NewKeeper(input.cdc, input.keySupply, input.vmAccountKeeper, input.bankKeeper, tests.MAccPerms),
+			input.ccsStorage,
+		)
+	} else {
+		input.bankKeeper = bank.NewBaseKeeper(input.accountKeeper, input.paramsKeeper.Subspace(bank.DefaultParamspace), tests.ModuleAccountAddrs())
+		input.supplyKeeper = vmsupply.NewKeeper(
+			supply.NewKeeper(input.cdc, input.keySupply, input.accountKeeper, input.bankKeeper, tests.MAccPerms),
+			input.ccsStorage,
+		)
+	}
 	//	cdc *codec.Codec, key sdk.StoreKey, supplyKeeper types.SupplyKeeper, paramstore params.Subspace,
 	input.stakingKeeper = staking.NewKeeper(input.cdc, input.keyStaking, input.supplyKeeper, input.paramsKeeper.Subspace(staking.DefaultParamspace))
-	input.keeper = NewKeeper(input.cdc, input.keyCC, input.bankKeeper, input.supplyKeeper, input.ccsStorage, &input.stakingKeeper)
+	input.keeper = NewKeeper(input.cdc, input.keyCC, input.vmAccountKeeper, input.bankKeeper, input.supplyKeeper, input.ccsStorage, &input.stakingKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dfinance/dnode/x/ccstorage"
@@ -13,3 +15,61 @@ func (k Keeper) CreateCurrency(ctx sdk.Context, params ccstorage.CurrencyParams)
 
 	return k.ccsKeeper.CreateCurrency(ctx, params)
 }
+
+// FreezeCurrency redirects FreezeCurrency request to the currencies storage.
+func (k Keeper) FreezeCurrency(ctx sdk.Context, denom string) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	return k.ccsKeeper.FreezeCurrency(ctx, denom)
+}
+
+// CreateTokenCurrency registers VM-native token currency and adds its VM supply to the supply module.
+func (k Keeper) CreateTokenCurrency(ctx sdk.Context, params ccstorage.TokenCurrencyParams) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	if err := k.ccsKeeper.CreateTokenCurrency(ctx, params); err != nil {
+		return err
+	}
+
+	currency, err := k.ccsKeeper.GetCurrency(ctx, params.Denom)
+	if err != nil {
+		return err
+	}
+	k.adjustTokenSupply(ctx, currency.Denom, sdk.ZeroInt(), currency.Supply)
+
+	return nil
+}
+
+// SyncTokenCurrenciesSupply updates VM-native token currencies supply using VM resources.
+// Token supply is changed by VM (mint / burn within Move module), so the supply module total is adjusted too.
+func (k Keeper) SyncTokenCurrenciesSupply(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	for _, currency := range k.ccsKeeper.GetCurrencies(ctx) {
+		if !currency.IsToken() {
+			continue
+		}
+
+		oldSupply, newSupply, err := k.ccsKeeper.UpdateTokenCurrencySupply(ctx, currency.Denom)
+		if err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("token currency %q supply sync: %v", currency.Denom, err))
+			continue
+		}
+		k.adjustTokenSupply(ctx, currency.Denom, oldSupply, newSupply)
+	}
+}
+
+// adjustTokenSupply changes the supply module total by VM-native token currency supply delta.
+func (k Keeper) adjustTokenSupply(ctx sdk.Context, denom string, oldSupply, newSupply sdk.Int) {
+	if oldSupply.Equal(newSupply) {
+		return
+	}
+
+	curSupply := k.supplyKeeper.GetSupply(ctx)
+	if newSupply.GT(oldSupply) {
+		curSupply = curSupply.SetTotal(curSupply.GetTotal().Add(sdk.NewCoin(denom, newSupply.Sub(oldSupply))))
+	} else {
+		curSupply = curSupply.SetTotal(curSupply.GetTotal().Sub(sdk.NewCoins(sdk.NewCoin(denom, oldSupply.Sub(newSupply)))))
+	}
+	k.supplyKeeper.SetSupply(ctx, curSupply)
+}
@@ -0,0 +1,135 @@
+// +build unit
+
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/ccstorage"
+	"github.com/dfinance/dnode/x/currencies/internal/types"
+)
+
+// Test keeper FreezeCurrency method.
+func TestCurrenciesKeeper_FreezeCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr := input.CreateAccount(t, "addr1", nil)
+	ctx, keeper := input.ctx, input.keeper
+
+	recipient := sdk.AccAddress("addr2")
+
+	// issue currency before freeze
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+
+	// ok
+	{
+		require.NoError(t, keeper.FreezeCurrency(ctx, defDenom))
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.True(t, currency.Frozen)
+	}
+
+	// fail: already frozen
+	{
+		require.Error(t, keeper.FreezeCurrency(ctx, defDenom))
+	}
+
+	// fail: non-existing currency
+	{
+		require.Error(t, keeper.FreezeCurrency(ctx, "test"))
+	}
+
+	// fail: issue
+	{
+		err := keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr)
+		require.Error(t, err)
+		require.True(t, types.ErrFrozenDenom.Is(err))
+		require.False(t, keeper.HasIssue(ctx, defIssueID2))
+	}
+
+	// fail: withdraw
+	{
+		err := keeper.WithdrawCurrency(ctx, defCoin, addr, recipient.String(), ctx.ChainID())
+		require.Error(t, err)
+		require.True(t, types.ErrFrozenDenom.Is(err))
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(defAmount))
+	}
+}
+
+// Test keeper CreateTokenCurrency and SyncTokenCurrenciesSupply methods.
+func TestCurrenciesKeeper_TokenCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	owner := input.CreateAccount(t, "token_owner_address1", nil)
+	ctx, keeper := input.ctx, input.keeper
+
+	params := ccstorage.TokenCurrencyParams{
+		Denom: "mytoken",
+		Token: ccstorage.CurrencyVMToken{
+			Address:    owner,
+			ModuleName: "MyToken",
+			StructName: "T",
+		},
+	}
+
+	// setVMToken emulates VM token mint / burn: updates CurrencyInfo and owner Balance resources
+	setVMToken := func(supply int64) {
+		infoBz, err := lcs.Marshal(ccstorage.ResCurrencyInfo{
+			Denom:       []byte(params.Denom),
+			Decimals:    6,
+			IsToken:     true,
+			Owner:       params.Token.VMAddress(),
+			TotalSupply: big.NewInt(supply),
+		})
+		require.NoError(t, err)
+		input.vmStorage.SetValue(ctx, &vm_grpc.VMAccessPath{Address: params.Token.VMAddress(), Path: params.Token.InfoPath()}, infoBz)
+
+		balanceBz, err := ccstorage.ResBalance{Value: big.NewInt(supply)}.Bytes()
+		require.NoError(t, err)
+		input.vmStorage.SetValue(ctx, &vm_grpc.VMAccessPath{Address: params.Token.VMAddress(), Path: params.Token.BalancePath()}, balanceBz)
+	}
+
+	checkState := func(supply int64) {
+		require.EqualValues(t, supply, input.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(params.Denom).Int64())
+		require.EqualValues(t, supply, input.vmAccountKeeper.GetAccount(ctx, owner).GetCoins().AmountOf(params.Denom).Int64())
+
+		for _, invariant := range []sdk.Invariant{TotalSupply(keeper), VMCurrencyInfoSupply(keeper), VMBalancesSupply(keeper)} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+
+	// ok
+	{
+		setVMToken(1000)
+		require.NoError(t, keeper.CreateTokenCurrency(ctx, params))
+		checkState(1000)
+	}
+
+	// ok: VM supply sync
+	{
+		setVMToken(1500)
+		keeper.SyncTokenCurrenciesSupply(ctx)
+		checkState(1500)
+
+		setVMToken(200)
+		keeper.SyncTokenCurrenciesSupply(ctx)
+		checkState(200)
+	}
+
+	// fail: issue / withdraw
+	{
+		coin := sdk.NewCoin(params.Denom, sdk.NewInt(1))
+		require.True(t, types.ErrVMTokenDenom.Is(keeper.IssueCurrency(ctx, defIssueID1, coin, owner)))
+		require.True(t, types.ErrVMTokenDenom.Is(keeper.WithdrawCurrency(ctx, coin, owner, owner.String(), "testnet")))
+	}
+}
@@ -43,6 +43,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 
 		k.storeWithdraw(ctx, withdraw)
 	}
+
+	// unstakes
+	for _, unstake := range state.Unstakes {
+		k.storeUnstake(ctx, unstake)
+	}
+
+	// unbans
+	for _, unban := range state.Unbans {
+		k.storeUnban(ctx, unban)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
@@ -52,6 +62,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	state := types.GenesisState{
 		Issues:    make([]types.GenesisIssue, 0),
 		Withdraws: types.Withdraws{},
+		Unstakes:  types.Unstakes{},
+		Unbans:    types.Unbans{},
 	}
 
 	// last withdrawID
@@ -66,5 +78,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	// withdraws
 	state.Withdraws = append(state.Withdraws, k.getWithdraws(ctx)...)
 
+	// unstakes
+	state.Unstakes = append(state.Unstakes, k.getUnstakes(ctx)...)
+
+	// unbans
+	state.Unbans = append(state.Unbans, k.getUnbans(ctx)...)
+
 	return k.cdc.MustMarshalJSON(state)
 }
@@ -67,6 +67,9 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 			),
 		},
 		LastWithdrawID: &lastID,
+		Unbans: types.Unbans{
+			types.NewUnban("unban1", sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 1),
+		},
 	}
 
 	// init
@@ -85,6 +88,8 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 		for i, getWithdraw := range keeper.getWithdraws(ctx) {
 			require.EqualValues(t, state.Withdraws[i], getWithdraw)
 		}
+		// unbans
+		require.Equal(t, state.Unbans, keeper.getUnbans(ctx))
 	}
 
 	// export
@@ -105,5 +110,7 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 		for i, getWithdraw := range keeper.getWithdraws(ctx) {
 			require.EqualValues(t, getWithdraw, state.Withdraws[i])
 		}
+		// unbans
+		require.Equal(t, keeper.getUnbans(ctx), state.Unbans)
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 
 	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
@@ -11,16 +12,14 @@ import (
 // RegisterInvariants registers all module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "vm-currency-info", VMCurrencyInfoSupply(k))
+	ir.RegisterRoute(types.ModuleName, "vm-balances", VMBalancesSupply(k))
 }
 
 // TotalSupply checks that the currency total supply and supply module amounts are equal.
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		supplyCoins := sdk.NewCoins()
-		if supply := k.supplyKeeper.GetSupply(ctx); supply != nil {
-			supplyCoins = supplyCoins.Add(supply.GetTotal()...)
-		}
-		supplyCoins.Sort()
+		supplyCoins := getSupplyCoins(ctx, k)
 
 		currenciesCoins := sdk.NewCoins()
 		for _, currency := range k.ccsKeeper.GetCurrencies(ctx) {
@@ -28,12 +27,90 @@ func TotalSupply(k Keeper) sdk.Invariant {
 		}
 		currenciesCoins.Sort()
 
-		broken := !currenciesCoins.IsEqual(currenciesCoins)
+		broken := !coinsEqual(currenciesCoins, supplyCoins)
 		irComment := fmt.Sprintf(
 			"\tccStorage.Supplies: %s\n\tsupply.Supplies: %s\n",
-			currenciesCoins.String(), currenciesCoins.String(),
+			currenciesCoins.String(), supplyCoins.String(),
 		)
 
 		return sdk.FormatInvariant(types.ModuleName, "total-supply", irComment), broken
 	}
 }
+
+// VMCurrencyInfoSupply checks that the VM CurrencyInfo resource total supply and currency supply are equal.
+func VMCurrencyInfoSupply(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		irComment := ""
+
+		for _, currency := range k.ccsKeeper.GetCurrencies(ctx) {
+			currencyInfo, err := k.ccsKeeper.GetResStdCurrencyInfo(ctx, currency.Denom)
+			if err != nil {
+				broken = true
+				irComment += fmt.Sprintf("\t%s: reading VM CurrencyInfo: %v\n", currency.Denom, err)
+				continue
+			}
+
+			if currencyInfo.TotalSupply == nil || currencyInfo.TotalSupply.Cmp(currency.Supply.BigInt()) != 0 {
+				broken = true
+				irComment += fmt.Sprintf(
+					"\t%s: ccStorage.Supply %s != VM.CurrencyInfo.TotalSupply %s\n",
+					currency.Denom, currency.Supply.String(), currencyInfo.TotalSupply.String(),
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "vm-currency-info", irComment), broken
+	}
+}
+
+// VMBalancesSupply checks that the sum of all accounts VM balance resources and supply module amounts are equal.
+func VMBalancesSupply(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supplyCoins := getSupplyCoins(ctx, k)
+
+		var iterErr error
+		balancesCoins := sdk.NewCoins()
+		// std keeper is used to prevent VM balances to account coins sync
+		k.accountKeeper.AccountKeeper.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+			balances, err := k.ccsKeeper.GetAccountBalanceResources(ctx, acc.GetAddress())
+			if err != nil {
+				iterErr = fmt.Errorf("reading balances for %s: %w", acc.GetAddress(), err)
+				return true
+			}
+			balancesCoins = balancesCoins.Add(balances.Coins()...)
+
+			return false
+		})
+		balancesCoins.Sort()
+
+		if iterErr != nil {
+			irComment := fmt.Sprintf("\t%v\n", iterErr)
+			return sdk.FormatInvariant(types.ModuleName, "vm-balances", irComment), true
+		}
+
+		broken := !coinsEqual(balancesCoins, supplyCoins)
+		irComment := fmt.Sprintf(
+			"\tVM.Balances: %s\n\tsupply.Supplies: %s\n",
+			balancesCoins.String(), supplyCoins.String(),
+		)
+
+		return sdk.FormatInvariant(types.ModuleName, "vm-balances", irComment), broken
+	}
+}
+
+// getSupplyCoins returns sorted supply module total coins.
+func getSupplyCoins(ctx sdk.Context, k Keeper) sdk.Coins {
+	supplyCoins := sdk.NewCoins()
+	if supply := k.supplyKeeper.GetSupply(ctx); supply != nil {
+		supplyCoins = supplyCoins.Add(supply.GetTotal()...)
+	}
+	supplyCoins.Sort()
+
+	return supplyCoins
+}
+
+// coinsEqual compares coins without panicking on denoms mismatch (unlike sdk.Coins.IsEqual).
+func coinsEqual(coinsA, coinsB sdk.Coins) bool {
+	return coinsA.IsAllGTE(coinsB) && coinsB.IsAllGTE(coinsA)
+}
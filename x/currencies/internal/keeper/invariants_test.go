@@ -0,0 +1,93 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Test module invariants.
+func TestCurrenciesKeeper_Invariants(t *testing.T) {
+	t.Parallel()
+
+	checkInvariants := func(t *testing.T, ctx sdk.Context, keeper Keeper, totalSupplyBroken, vmCurInfoBroken, vmBalancesBroken bool) {
+		msg, broken := TotalSupply(keeper)(ctx)
+		require.Equal(t, totalSupplyBroken, broken, "total-supply: %s", msg)
+
+		msg, broken = VMCurrencyInfoSupply(keeper)(ctx)
+		require.Equal(t, vmCurInfoBroken, broken, "vm-currency-info: %s", msg)
+
+		msg, broken = VMBalancesSupply(keeper)(ctx)
+		require.Equal(t, vmBalancesBroken, broken, "vm-balances: %s", msg)
+	}
+
+	// syncVMBalances updates account VM balance resources (done by the vmauth keeper within the app)
+	syncVMBalances := func(t *testing.T, input TestInput, addr sdk.AccAddress) {
+		input.vmAccountKeeper.SetAccount(input.ctx, input.accountKeeper.GetAccount(input.ctx, addr))
+	}
+
+	// ok
+	{
+		input := NewTestInput(t)
+		addr := input.CreateAccount(t, "addr1", nil)
+		ctx, keeper := input.ctx, input.keeper
+
+		checkInvariants(t, ctx, keeper, false, false, false)
+
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+		syncVMBalances(t, input, addr)
+		checkInvariants(t, ctx, keeper, false, false, false)
+
+		require.NoError(t, keeper.WithdrawCurrency(ctx, defCoin, addr, addr.String(), "testnet"))
+		syncVMBalances(t, input, addr)
+		checkInvariants(t, ctx, keeper, false, false, false)
+	}
+
+	// fail: ccStorage supply mismatch
+	{
+		input := NewTestInput(t)
+		addr := input.CreateAccount(t, "addr1", nil)
+		ctx, keeper := input.ctx, input.keeper
+
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+		syncVMBalances(t, input, addr)
+		require.NoError(t, input.ccsStorage.IncreaseCurrencySupply(ctx, defCoin))
+		checkInvariants(t, ctx, keeper, true, false, false)
+	}
+
+	// fail: VM CurrencyInfo resource not found
+	{
+		input := NewTestInput(t)
+		addr := input.CreateAccount(t, "addr1", nil)
+		ctx, keeper := input.ctx, input.keeper
+
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+		syncVMBalances(t, input, addr)
+		input.vmStorage.DelValue(ctx, &vm_grpc.VMAccessPath{
+			Address: common_vm.StdLibAddress,
+			Path:    glav.CurrencyInfoVector(defDenom),
+		})
+		checkInvariants(t, ctx, keeper, false, true, false)
+	}
+
+	// fail: VM balances mismatch
+	{
+		input := NewTestInput(t)
+		addr := input.CreateAccount(t, "addr1", nil)
+		ctx, keeper := input.ctx, input.keeper
+
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+
+		acc := input.accountKeeper.GetAccount(ctx, addr)
+		require.NoError(t, acc.SetCoins(acc.GetCoins().Add(defCoin)))
+		input.vmAccountKeeper.SetAccount(ctx, acc)
+		checkInvariants(t, ctx, keeper, false, false, true)
+	}
+}
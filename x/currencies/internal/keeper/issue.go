@@ -27,10 +27,17 @@ func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee s
 		return sdkErrors.Wrapf(types.ErrWrongIssueID, "issue with ID %q: already exists", id)
 	}
 
-	// check and update currency
-	if _, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom); err != nil {
+	// check currency
+	currency, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom)
+	if err != nil {
 		return err
 	}
+	if currency.Frozen {
+		return sdkErrors.Wrapf(types.ErrFrozenDenom, "currency %q", coin.Denom)
+	}
+	if currency.IsToken() {
+		return sdkErrors.Wrapf(types.ErrVMTokenDenom, "currency %q", coin.Denom)
+	}
 
 	// store issue
 	issue := types.NewIssue(coin, payee)
@@ -41,11 +48,7 @@ func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee s
 		return sdkErrors.Wrapf(types.ErrInternal, "bankKeeper.AddCoins for address %q: %v", payee.String(), err)
 	}
 
-	// increase supply
-	if err := k.ccsKeeper.IncreaseCurrencySupply(ctx, coin); err != nil {
-		return err
-	}
-
+	// increase supply (currency supply is updated by the supply keeper)
 	curSupply := k.supplyKeeper.GetSupply(ctx)
 	curSupply = curSupply.SetTotal(curSupply.GetTotal().Add(coin))
 	k.supplyKeeper.SetSupply(ctx, curSupply)
@@ -55,6 +58,32 @@ func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee s
 	return
 }
 
+// IssueCurrencyBatch issues currencies for all batch entries.
+// Batch is processed atomically: state is changed only if all entries succeed.
+// Batch issue is a multisig operation.
+func (k Keeper) IssueCurrencyBatch(ctx sdk.Context, entries []types.IssueBatchEntry) error {
+	k.modulePerms.AutoCheck(types.PermIssue)
+
+	for i, entry := range entries {
+		if k.HasIssue(ctx, entry.ID) {
+			return sdkErrors.Wrapf(types.ErrWrongIssueID, "entry [%d]: issue with ID %q: already exists", i, entry.ID)
+		}
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	for i, entry := range entries {
+		if err := k.IssueCurrency(cacheCtx, entry.ID, entry.Coin, entry.Payee); err != nil {
+			return sdkErrors.Wrapf(err, "entry [%d]", i)
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
 // HasIssue checks that issue exists.
 func (k Keeper) HasIssue(ctx sdk.Context, id string) bool {
 	k.modulePerms.AutoCheck(types.PermRead)
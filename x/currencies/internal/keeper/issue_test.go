@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
 
 // Test keeper IssueCurrency method.
@@ -114,3 +116,59 @@ func TestCurrenciesKeeper_IssueHugeAmount(t *testing.T) {
 	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr))
 	require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(amount))
 }
+
+// Test keeper IssueCurrencyBatch method.
+func TestCurrenciesKeeper_IssueCurrencyBatch(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr1 := input.CreateAccount(t, "addr1", nil)
+	addr2 := input.CreateAccount(t, "addr2", nil)
+	ctx, keeper := input.ctx, input.keeper
+
+	// ok
+	{
+		entries := []types.IssueBatchEntry{
+			{ID: defIssueID1, Coin: defCoin, Payee: addr1},
+			{ID: defIssueID2, Coin: defCoin, Payee: addr2},
+		}
+		require.NoError(t, keeper.IssueCurrencyBatch(ctx, entries))
+
+		require.True(t, keeper.HasIssue(ctx, defIssueID1))
+		require.True(t, keeper.HasIssue(ctx, defIssueID2))
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr1).AmountOf(defDenom).Equal(defAmount))
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr2).AmountOf(defDenom).Equal(defAmount))
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.True(t, currency.Supply.Equal(defAmount.MulRaw(2)))
+	}
+
+	// fail: existing issueID, nothing is issued
+	{
+		entries := []types.IssueBatchEntry{
+			{ID: "issue3", Coin: defCoin, Payee: addr1},
+			{ID: defIssueID1, Coin: defCoin, Payee: addr1},
+		}
+		require.Error(t, keeper.IssueCurrencyBatch(ctx, entries))
+
+		require.False(t, keeper.HasIssue(ctx, "issue3"))
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr1).AmountOf(defDenom).Equal(defAmount))
+	}
+
+	// fail: entry error reverts previous entries
+	{
+		entries := []types.IssueBatchEntry{
+			{ID: "issue3", Coin: defCoin, Payee: addr1},
+			{ID: "issue4", Coin: sdk.NewCoin("unknown", defAmount), Payee: addr1},
+		}
+		require.Error(t, keeper.IssueCurrencyBatch(ctx, entries))
+
+		require.False(t, keeper.HasIssue(ctx, "issue3"))
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr1).AmountOf(defDenom).Equal(defAmount))
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.True(t, currency.Supply.Equal(defAmount.MulRaw(2)))
+	}
+}
@@ -6,20 +6,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
-	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/dfinance/dnode/helpers/perms"
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/currencies/internal/types"
+	"github.com/dfinance/dnode/x/vmauth"
+	"github.com/dfinance/dnode/x/vmsupply"
 )
 
 // Module keeper object.
 type Keeper struct {
 	cdc           *cdcCodec.Codec
 	storeKey      sdk.StoreKey
+	accountKeeper vmauth.Keeper
 	bankKeeper    bank.Keeper
-	supplyKeeper  supply.Keeper
+	supplyKeeper  vmsupply.Keeper
 	ccsKeeper     ccstorage.Keeper
 	stakingKeeper *staking.Keeper
 	modulePerms   perms.ModulePermissions
@@ -34,8 +36,9 @@ func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 func NewKeeper(
 	cdc *cdcCodec.Codec,
 	storeKey sdk.StoreKey,
+	accountKeeper vmauth.Keeper,
 	bankKeeper bank.Keeper,
-	supplyKeeper supply.Keeper,
+	supplyKeeper vmsupply.Keeper,
 	ccsKeeper ccstorage.Keeper,
 	stakingKeeper *staking.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
@@ -43,6 +46,7 @@ func NewKeeper(
 	k := Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
+		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		supplyKeeper:  supplyKeeper,
 		ccsKeeper:     ccsKeeper,
@@ -23,6 +23,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetCurrency(k, ctx, req)
 		case types.QueryCurrencies:
 			return queryGetCurrencies(k, ctx)
+		case types.QueryUnstake:
+			return queryGetUnstake(k, ctx, req)
+		case types.QueryUnban:
+			return queryGetUnban(k, ctx, req)
+		case types.QueryBanned:
+			return queryGetBannedAccounts(k, ctx)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -120,3 +126,55 @@ func queryGetCurrencies(k Keeper, ctx sdk.Context) ([]byte, error) {
 
 	return bz, nil
 }
+
+// queryGetUnstake handles getUnstake query which return unstake by id.
+func queryGetUnstake(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	params := types.UnstakeReq{}
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	unstake, err := k.GetUnstake(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, unstake)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "unstake marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryGetUnban handles getUnban query which return unban by id.
+func queryGetUnban(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	params := types.UnbanReq{}
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	unban, err := k.GetUnban(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, unban)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "unban marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryGetBannedAccounts handles getBannedAccounts query which return all banned accounts.
+func queryGetBannedAccounts(k Keeper, ctx sdk.Context) ([]byte, error) {
+	accounts := k.GetBannedAccounts(ctx)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, accounts)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "banned accounts marshal: %v", err)
+	}
+
+	return bz, nil
+}
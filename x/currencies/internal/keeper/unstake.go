@@ -7,7 +7,14 @@ import (
 	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
 
-func (k Keeper) UnstakeCurrency(ctx sdk.Context, staker sdk.AccAddress) error {
+// UnstakeCurrency force removes all staker delegations, burns staking / liquidity coins and bans the staker.
+// Unstake record is stored by {id}.
+// Unstake is a multisig operation.
+func (k Keeper) UnstakeCurrency(ctx sdk.Context, id string, staker sdk.AccAddress) error {
+	if k.HasUnstake(ctx, id) {
+		return sdkErrors.Wrapf(types.ErrWrongUnstakeID, "unstake with ID %q: already exists", id)
+	}
+
 	// Get staking denom (usually sxfi).
 	stakingDenom := k.stakingKeeper.BondDenom(ctx)
 	liquidityDenom := k.stakingKeeper.LPDenom(ctx)
@@ -20,18 +27,15 @@ func (k Keeper) UnstakeCurrency(ctx sdk.Context, staker sdk.AccAddress) error {
 	// Check balance and remove staking/liquidity coins.
 	balances := k.bankKeeper.GetCoins(ctx, staker)
 	curSupply := k.supplyKeeper.GetSupply(ctx)
+	burnedCoins := sdk.NewCoins()
 
 	for _, balance := range balances {
 		if balance.Denom == stakingDenom || balance.Denom == liquidityDenom {
 			// Remove sxfi from balance.
 			balances = balances.Sub(sdk.Coins{balance})
+			burnedCoins = burnedCoins.Add(balance)
 
-			// Reducing supply.
-			// Both keepers (ccs and supply).
-			if err := k.ccsKeeper.DecreaseCurrencySupply(ctx, balance); err != nil {
-				return sdkErrors.Wrapf(types.ErrInternal, "can't decrease supply with ccsKeeper for %s: %v", staker, err)
-			}
-
+			// Reducing supply (currency supply is updated by the supply keeper).
 			curSupply = curSupply.SetTotal(curSupply.GetTotal().Sub(sdk.Coins{balance}))
 		}
 	}
@@ -46,5 +50,158 @@ func (k Keeper) UnstakeCurrency(ctx sdk.Context, staker sdk.AccAddress) error {
 	// Ban account.
 	k.stakingKeeper.BanAccount(ctx, staker, ctx.BlockHeight())
 
+	// Store unstake.
+	k.storeUnstake(ctx, types.NewUnstake(id, staker, burnedCoins, ctx.BlockHeight()))
+
+	return nil
+}
+
+// UnbanAccount removes account staking operations ban.
+// Unban record is stored by {id}.
+// Unban is a multisig operation.
+func (k Keeper) UnbanAccount(ctx sdk.Context, id string, accAddr sdk.AccAddress) error {
+	if k.HasUnban(ctx, id) {
+		return sdkErrors.Wrapf(types.ErrWrongUnbanID, "unban with ID %q: already exists", id)
+	}
+
+	if !k.stakingKeeper.IsAccountBanned(ctx, accAddr) {
+		return sdkErrors.Wrapf(types.ErrAccountNotBanned, "account %s", accAddr)
+	}
+
+	k.stakingKeeper.UnbanAccount(ctx, accAddr)
+
+	// Store unban.
+	unban := types.NewUnban(id, accAddr, ctx.BlockHeight())
+	k.storeUnban(ctx, unban)
+
+	ctx.EventManager().EmitEvent(types.NewUnbanEvent(unban))
+
 	return nil
 }
+
+// GetBannedAccounts returns all accounts banned for staking operations.
+func (k Keeper) GetBannedAccounts(ctx sdk.Context) types.BannedAccounts {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	accounts := make(types.BannedAccounts, 0)
+	k.stakingKeeper.IterateBannedAccounts(ctx, func(accAddr sdk.AccAddress, banHeight int64) (stop bool) {
+		accounts = append(accounts, types.BannedAccount{
+			Address: accAddr,
+			Height:  banHeight,
+		})
+
+		return false
+	})
+
+	return accounts
+}
+
+// HasUnstake checks that unstake exists.
+func (k Keeper) HasUnstake(ctx sdk.Context, id string) bool {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetUnstakeKey(id))
+}
+
+// GetUnstake returns unstake.
+func (k Keeper) GetUnstake(ctx sdk.Context, id string) (types.Unstake, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.HasUnstake(ctx, id) {
+		return types.Unstake{}, sdkErrors.Wrapf(types.ErrWrongUnstakeID, "unstakeID %q: not found", id)
+	}
+
+	return k.getUnstake(ctx, id), nil
+}
+
+// getUnstake returns unstake from the storage.
+func (k Keeper) getUnstake(ctx sdk.Context, id string) types.Unstake {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnstakeKey(id))
+
+	unstake := types.Unstake{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &unstake)
+
+	return unstake
+}
+
+// getUnstakes returns all registered unstakes from the storage.
+func (k Keeper) getUnstakes(ctx sdk.Context) types.Unstakes {
+	unstakes := types.Unstakes{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUnstakesPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unstake types.Unstake
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &unstake)
+
+		unstakes = append(unstakes, unstake)
+	}
+
+	return unstakes
+}
+
+// storeUnstake sets unstake to the storage.
+func (k Keeper) storeUnstake(ctx sdk.Context, unstake types.Unstake) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnstakeKey(unstake.ID), k.cdc.MustMarshalBinaryBare(unstake))
+}
+
+// HasUnban checks that unban exists.
+func (k Keeper) HasUnban(ctx sdk.Context, id string) bool {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetUnbanKey(id))
+}
+
+// GetUnban returns unban.
+func (k Keeper) GetUnban(ctx sdk.Context, id string) (types.Unban, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.HasUnban(ctx, id) {
+		return types.Unban{}, sdkErrors.Wrapf(types.ErrWrongUnbanID, "unbanID %q: not found", id)
+	}
+
+	return k.getUnban(ctx, id), nil
+}
+
+// getUnban returns unban from the storage.
+func (k Keeper) getUnban(ctx sdk.Context, id string) types.Unban {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbanKey(id))
+
+	unban := types.Unban{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &unban)
+
+	return unban
+}
+
+// getUnbans returns all registered unbans from the storage.
+func (k Keeper) getUnbans(ctx sdk.Context) types.Unbans {
+	unbans := types.Unbans{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUnbansPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unban types.Unban
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &unban)
+
+		unbans = append(unbans, unban)
+	}
+
+	return unbans
+}
+
+// storeUnban sets unban to the storage.
+func (k Keeper) storeUnban(ctx sdk.Context, unban types.Unban) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbanKey(unban.ID), k.cdc.MustMarshalBinaryBare(unban))
+}
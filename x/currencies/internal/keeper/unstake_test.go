@@ -0,0 +1,91 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+)
+
+// Test keeper UnstakeCurrency and UnbanAccount methods.
+func TestCurrenciesKeeper_UnstakeUnban(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+	input.stakingKeeper.SetParams(ctx, staking.DefaultParams())
+
+	bondCoin := sdk.NewCoin(input.stakingKeeper.BondDenom(ctx), sdk.NewInt(100))
+	accCoins := sdk.NewCoins(bondCoin, defCoin)
+	// staking keeper requires 20 bytes address
+	addr := input.CreateAccount(t, "unstake_test_addr_01", accCoins)
+	input.supplyKeeper.SetSupply(ctx, supply.NewSupply(accCoins))
+
+	// ok: unstake
+	{
+		require.NoError(t, keeper.UnstakeCurrency(ctx, "unstake1", addr))
+
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(defCoin)))
+		require.True(t, input.supplyKeeper.GetSupply(ctx).GetTotal().IsEqual(sdk.NewCoins(defCoin)))
+
+		unstake, err := keeper.GetUnstake(ctx, "unstake1")
+		require.NoError(t, err)
+		require.Equal(t, "unstake1", unstake.ID)
+		require.Equal(t, addr, unstake.Staker)
+		require.True(t, unstake.BurnedCoins.IsEqual(sdk.NewCoins(bondCoin)))
+		require.Equal(t, ctx.BlockHeight(), unstake.Height)
+
+		banned := keeper.GetBannedAccounts(ctx)
+		require.Len(t, banned, 1)
+		require.Equal(t, addr, banned[0].Address)
+
+		require.Error(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+	}
+
+	// fail: existing unstakeID
+	{
+		require.Error(t, keeper.UnstakeCurrency(ctx, "unstake1", addr))
+	}
+
+	// fail: non-existing unstake
+	{
+		_, err := keeper.GetUnstake(ctx, "unstake_non_existing")
+		require.Error(t, err)
+	}
+
+	// ok: unban
+	{
+		require.NoError(t, keeper.UnbanAccount(ctx, "unban1", addr))
+		require.Empty(t, keeper.GetBannedAccounts(ctx))
+
+		unban, err := keeper.GetUnban(ctx, "unban1")
+		require.NoError(t, err)
+		require.Equal(t, "unban1", unban.ID)
+		require.Equal(t, addr, unban.Address)
+		require.Equal(t, ctx.BlockHeight(), unban.Height)
+
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+	}
+
+	// fail: existing unbanID
+	{
+		require.NoError(t, keeper.UnstakeCurrency(ctx, "unstake2", addr))
+		require.Error(t, keeper.UnbanAccount(ctx, "unban1", addr))
+	}
+
+	// fail: account not banned
+	{
+		require.NoError(t, keeper.UnbanAccount(ctx, "unban2", addr))
+		require.Error(t, keeper.UnbanAccount(ctx, "unban3", addr))
+	}
+
+	// fail: non-existing unban
+	{
+		_, err := keeper.GetUnban(ctx, "unban3")
+		require.Error(t, err)
+	}
+}
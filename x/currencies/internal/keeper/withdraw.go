@@ -19,10 +19,17 @@ func (k Keeper) WithdrawCurrency(ctx sdk.Context, coin sdk.Coin, spender sdk.Acc
 		}
 	}()
 
-	// check and update currency
-	if _, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom); err != nil {
+	// check currency
+	currency, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom)
+	if err != nil {
 		return err
 	}
+	if currency.Frozen {
+		return sdkErrors.Wrapf(types.ErrFrozenDenom, "currency %q", coin.Denom)
+	}
+	if currency.IsToken() {
+		return sdkErrors.Wrapf(types.ErrVMTokenDenom, "currency %q", coin.Denom)
+	}
 
 	// store withdraw
 	newId := k.getNextWithdrawID(ctx)
@@ -37,11 +44,7 @@ func (k Keeper) WithdrawCurrency(ctx sdk.Context, coin sdk.Coin, spender sdk.Acc
 		return err
 	}
 
-	// decrease supply
-	if err := k.ccsKeeper.DecreaseCurrencySupply(ctx, coin); err != nil {
-		return err
-	}
-
+	// decrease supply (currency supply is updated by the supply keeper)
 	curSupply := k.supplyKeeper.GetSupply(ctx)
 	curSupply = curSupply.SetTotal(curSupply.GetTotal().Sub(newCoins))
 	k.supplyKeeper.SetSupply(ctx, curSupply)
@@ -6,10 +6,13 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/currencies/internal/types"
+	"github.com/dfinance/dnode/x/vm"
 )
 
 // Test keeper WithdrawCurrency method.
@@ -62,6 +65,85 @@ func TestCurrenciesKeeper_WithdrawCurrency(t *testing.T) {
 	}
 }
 
+// Test keeper IssueCurrency / WithdrawCurrency methods with account balance changed by VM (in-process mockDVM backend).
+func TestCurrenciesKeeper_IssueWithdrawVMWrites(t *testing.T) {
+	t.Parallel()
+
+	input := NewMockDVMTestInput(t)
+	addr := input.CreateAccount(t, "addr1", nil)
+	ctx, keeper := input.ctx, input.keeper
+
+	recipient := sdk.AccAddress("addr2")
+
+	// account balance resource might not exist (zero balance)
+	var balancePath *vm_grpc.VMAccessPath
+	getBalanceAmount := func() sdk.Int {
+		balances, err := input.ccsStorage.GetAccountBalanceResources(ctx, addr)
+		require.NoError(t, err)
+		for _, balance := range balances {
+			if balance.Denom == defDenom {
+				balancePath = balance.AccessPath
+				return balance.Coin().Amount
+			}
+		}
+
+		return sdk.ZeroInt()
+	}
+
+	execScript := func(script []byte, writeSet []*vm_grpc.VMValue) {
+		input.mockDVM.SetExecResult(script, writeSet, nil)
+		require.NoError(t, input.vmKeeper.ExecuteScript(ctx, vm.NewMsgExecuteScript(addr, script, nil)))
+	}
+
+	// ok: issue writes account balance resource to the VM storage
+	{
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+
+		require.Equal(t, defAmount.String(), getBalanceAmount().String())
+		require.True(t, input.vmKeeper.HasValue(ctx, balancePath))
+	}
+
+	// ok: script decreases account balance (partial transfer within VM)
+	{
+		resBz, err := ccstorage.ResBalance{Value: sdk.NewInt(4).BigInt()}.Bytes()
+		require.NoError(t, err)
+
+		execScript([]byte{0x1}, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: balancePath, Value: resBz}})
+		require.Equal(t, "4", getBalanceAmount().String())
+		require.Equal(t, "4", input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).String())
+	}
+
+	// fail: withdraw of the issued amount (balance was decreased by VM)
+	{
+		require.Error(t, keeper.WithdrawCurrency(ctx, defCoin, addr, recipient.String(), ctx.ChainID()))
+	}
+
+	// ok: withdraw of the VM balance
+	{
+		coin := sdk.NewCoin(defDenom, sdk.NewInt(4))
+		require.NoError(t, keeper.WithdrawCurrency(ctx, coin, addr, recipient.String(), ctx.ChainID()))
+
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).IsZero())
+		require.True(t, getBalanceAmount().IsZero())
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.Equal(t, "6", currency.Supply.String())
+	}
+
+	// ok: script writes balance, issue adds to it
+	{
+		resBz, err := ccstorage.ResBalance{Value: sdk.NewInt(1).BigInt()}.Bytes()
+		require.NoError(t, err)
+
+		execScript([]byte{0x2}, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: balancePath, Value: resBz}})
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr))
+
+		require.Equal(t, "11", getBalanceAmount().String())
+		require.Equal(t, "11", input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).String())
+	}
+}
+
 // Test keeper GetWithdraw method.
 func TestCurrenciesKeeper_GetWithdraw(t *testing.T) {
 	t.Parallel()
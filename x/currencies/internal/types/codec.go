@@ -8,10 +8,14 @@ import (
 )
 
 const (
-	CodecNameMsgIssueCurrency    = ModuleName + "/IssueCurrency"
-	CodecNameMsgWithdrawCurrency = ModuleName + "/WithdrawCurrency"
-	CodecNameAddCurrencyProposal = ModuleName + "/AddCurrencyProposal"
-	CodecNameMsgUnstakeCurrency  = ModuleName + "/UnstakeCurrency"
+	CodecNameMsgIssueCurrency         = ModuleName + "/IssueCurrency"
+	CodecNameMsgWithdrawCurrency      = ModuleName + "/WithdrawCurrency"
+	CodecNameAddCurrencyProposal      = ModuleName + "/AddCurrencyProposal"
+	CodecNameMsgUnstakeCurrency       = ModuleName + "/UnstakeCurrency"
+	CodecNameFreezeCurrencyProposal   = ModuleName + "/FreezeCurrencyProposal"
+	CodecNameMsgIssueCurrencyBatch    = ModuleName + "/IssueCurrencyBatch"
+	CodecNameMsgUnbanAccount          = ModuleName + "/UnbanAccount"
+	CodecNameAddTokenCurrencyProposal = ModuleName + "/AddTokenCurrencyProposal"
 )
 
 var ModuleCdc *codec.Codec
@@ -22,6 +26,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawCurrency{}, CodecNameMsgWithdrawCurrency, nil)
 	cdc.RegisterConcrete(AddCurrencyProposal{}, CodecNameAddCurrencyProposal, nil)
 	cdc.RegisterConcrete(MsgUnstakeCurrency{}, CodecNameMsgUnstakeCurrency, nil)
+	cdc.RegisterConcrete(FreezeCurrencyProposal{}, CodecNameFreezeCurrencyProposal, nil)
+	cdc.RegisterConcrete(MsgIssueCurrencyBatch{}, CodecNameMsgIssueCurrencyBatch, nil)
+	cdc.RegisterConcrete(MsgUnbanAccount{}, CodecNameMsgUnbanAccount, nil)
+	cdc.RegisterConcrete(AddTokenCurrencyProposal{}, CodecNameAddTokenCurrencyProposal, nil)
 }
 
 func init() {
@@ -33,7 +41,13 @@ func init() {
 	msClient.RegisterMultiSigTypeCodec(MsgIssueCurrency{}, CodecNameMsgIssueCurrency)
 	msClient.RegisterMultiSigTypeCodec(MsgUnstakeCurrency{}, CodecNameMsgUnstakeCurrency)
 	msClient.RegisterMultiSigTypeCodec(MsgWithdrawCurrency{}, CodecNameMsgWithdrawCurrency)
+	msClient.RegisterMultiSigTypeCodec(MsgIssueCurrencyBatch{}, CodecNameMsgIssueCurrencyBatch)
+	msClient.RegisterMultiSigTypeCodec(MsgUnbanAccount{}, CodecNameMsgUnbanAccount)
 
 	gov.RegisterProposalType(ProposalTypeAddCurrency)
 	gov.RegisterProposalTypeCodec(AddCurrencyProposal{}, CodecNameAddCurrencyProposal)
+	gov.RegisterProposalType(ProposalTypeFreezeCurrency)
+	gov.RegisterProposalTypeCodec(FreezeCurrencyProposal{}, CodecNameFreezeCurrencyProposal)
+	gov.RegisterProposalType(ProposalTypeAddTokenCurrency)
+	gov.RegisterProposalTypeCodec(AddTokenCurrencyProposal{}, CodecNameAddTokenCurrencyProposal)
 }
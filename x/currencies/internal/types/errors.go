@@ -11,8 +11,14 @@ var (
 	ErrWrongIssueID       = sdkErrors.Register(ModuleName, 103, "wrong issueID")
 	ErrWrongWithdrawID    = sdkErrors.Register(ModuleName, 104, "wrong withdrawID")
 	ErrWrongPegZonePayee  = sdkErrors.Register(ModuleName, 105, "wrong PegZone payee")
+	ErrFrozenDenom        = sdkErrors.Register(ModuleName, 106, "currency is frozen")
+	ErrWrongIssueBatch    = sdkErrors.Register(ModuleName, 107, "wrong issue batch")
+	ErrWrongUnstakeID     = sdkErrors.Register(ModuleName, 108, "wrong unstakeID")
+	ErrWrongUnbanID       = sdkErrors.Register(ModuleName, 109, "wrong unbanID")
+	ErrVMTokenDenom       = sdkErrors.Register(ModuleName, 110, "operation is not supported for VM token currency")
 	ErrGovInvalidProposal = sdkErrors.Register(ModuleName, 200, "invalid proposal")
 	ErrForceUnstake       = sdkErrors.Register(ModuleName, 300, "force unstake")
 	ErrNulifyBalance      = sdkErrors.Register(ModuleName, 301, "nullify balance")
 	ErrAccountBanned      = sdkErrors.Register(ModuleName, 303, "account banned for staking operations")
+	ErrAccountNotBanned   = sdkErrors.Register(ModuleName, 304, "account not banned")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -9,11 +11,14 @@ import (
 const (
 	EventTypesIssue    = ModuleName + ".issue"
 	EventTypesWithdraw = ModuleName + ".withdraw"
+	EventTypesUnban    = ModuleName + ".unban"
 	//
 	AttributeDenom      = "denom"
 	AttributeAmount     = "amount"
 	AttributeIssueId    = "issue_id"
 	AttributeWithdrawId = "withdraw_id"
+	AttributeUnbanId    = "unban_id"
+	AttributeHeight     = "height"
 	AttributeSender     = "sender"
 )
 
@@ -38,3 +43,13 @@ func NewWithdrawEvent(id dnTypes.ID, coin sdk.Coin, spender sdk.AccAddress) sdk.
 		sdk.NewAttribute(AttributeSender, spender.String()),
 	)
 }
+
+// NewUnbanEvent creates an Event on account staking operations ban removal.
+func NewUnbanEvent(unban Unban) sdk.Event {
+	return sdk.NewEvent(
+		EventTypesUnban,
+		sdk.NewAttribute(AttributeUnbanId, unban.ID),
+		sdk.NewAttribute(AttributeSender, unban.Address.String()),
+		sdk.NewAttribute(AttributeHeight, strconv.FormatInt(unban.Height, 10)),
+	)
+}
@@ -12,6 +12,8 @@ type GenesisState struct {
 	Issues         []GenesisIssue `json:"issues" yaml:"issues"`
 	Withdraws      Withdraws      `json:"withdraws" yaml:"withdraws"`
 	LastWithdrawID *dnTypes.ID    `json:"last_withdraw_id" yaml:"last_withdraw_id"`
+	Unstakes       Unstakes       `json:"unstakes" yaml:"unstakes"`
+	Unbans         Unbans         `json:"unbans" yaml:"unbans"`
 }
 
 // Valid checks that genesis state is valid.
@@ -61,6 +63,30 @@ func (s GenesisState) Validate(curBlockTime time.Time) error {
 		}
 	}
 
+	unstakeIdsSet := make(map[string]bool, len(s.Unstakes))
+	for i, unstake := range s.Unstakes {
+		if err := unstake.Valid(); err != nil {
+			return fmt.Errorf("unstake[%d]: %w", i, err)
+		}
+
+		if unstakeIdsSet[unstake.ID] {
+			return fmt.Errorf("unstake[%d]: duplicated ID %q", i, unstake.ID)
+		}
+		unstakeIdsSet[unstake.ID] = true
+	}
+
+	unbanIdsSet := make(map[string]bool, len(s.Unbans))
+	for i, unban := range s.Unbans {
+		if err := unban.Valid(); err != nil {
+			return fmt.Errorf("unban[%d]: %w", i, err)
+		}
+
+		if unbanIdsSet[unban.ID] {
+			return fmt.Errorf("unban[%d]: duplicated ID %q", i, unban.ID)
+		}
+		unbanIdsSet[unban.ID] = true
+	}
+
 	return nil
 }
 
@@ -88,5 +114,7 @@ func DefaultGenesisState() GenesisState {
 		LastWithdrawID: nil,
 		Issues:         make([]GenesisIssue, 0),
 		Withdraws:      Withdraws{},
+		Unstakes:       Unstakes{},
+		Unbans:         Unbans{},
 	}
 }
@@ -133,6 +133,44 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 		}
 		require.Error(t, state.Validate(time.Time{}))
 	}
+	// fail: invalid unstake
+	{
+		state := GenesisState{
+			Unstakes: Unstakes{
+				NewUnstake("", addr, sdk.NewCoins(coin), 1),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: duplicated unstakes
+	{
+		state := GenesisState{
+			Unstakes: Unstakes{
+				NewUnstake("1", addr, sdk.NewCoins(coin), 1),
+				NewUnstake("1", addr, sdk.NewCoins(coin), 2),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: invalid unban
+	{
+		state := GenesisState{
+			Unbans: Unbans{
+				NewUnban("1", sdk.AccAddress{}, 1),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: duplicated unbans
+	{
+		state := GenesisState{
+			Unbans: Unbans{
+				NewUnban("1", addr, 1),
+				NewUnban("1", addr, 2),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
 	// ok
 	{
 		id := dnTypes.NewIDFromUint64(3)
@@ -153,6 +191,13 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 				NewWithdraw(dnTypes.NewIDFromUint64(3), coin, addr, pgPayee, pgChainID, timestamp, txHash),
 			},
 			LastWithdrawID: &id,
+			Unstakes: Unstakes{
+				NewUnstake("1", addr, sdk.NewCoins(coin), 1),
+				NewUnstake("2", addr, sdk.NewCoins(), 2),
+			},
+			Unbans: Unbans{
+				NewUnban("1", addr, 3),
+			},
 		}
 		require.NoError(t, state.Validate(time.Time{}))
 	}
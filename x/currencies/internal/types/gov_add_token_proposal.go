@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/ccstorage"
+)
+
+const (
+	ProposalTypeAddTokenCurrency = "AddTokenCurrency"
+)
+
+var (
+	_ gov.Content = AddTokenCurrencyProposal{}
+)
+
+// AddTokenCurrencyProposal is a gov proposal to register VM-native token (Move module struct) as a currency.
+// Token currency decimals and supply are taken from the VM CurrencyInfo resource.
+type AddTokenCurrencyProposal struct {
+	Denom      string
+	Address    sdk.AccAddress
+	ModuleName string
+	StructName string
+}
+
+func (p AddTokenCurrencyProposal) GetTitle() string       { return "Add token currency" }
+func (p AddTokenCurrencyProposal) GetDescription() string { return "Registers VM-native token as a currency" }
+func (p AddTokenCurrencyProposal) ProposalRoute() string  { return GovRouterKey }
+func (p AddTokenCurrencyProposal) ProposalType() string   { return ProposalTypeAddTokenCurrency }
+
+func (p AddTokenCurrencyProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	if err := p.GetTokenCurrencyParams().Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
+	return nil
+}
+
+func (p AddTokenCurrencyProposal) GetTokenCurrencyParams() ccstorage.TokenCurrencyParams {
+	return ccstorage.TokenCurrencyParams{
+		Denom: p.Denom,
+		Token: ccstorage.CurrencyVMToken{
+			Address:    p.Address,
+			ModuleName: p.ModuleName,
+			StructName: p.StructName,
+		},
+	}
+}
+
+func (p AddTokenCurrencyProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s\n", p.Denom))
+	b.WriteString(fmt.Sprintf("  Address: %s\n", p.Address))
+	b.WriteString(fmt.Sprintf("  ModuleName: %s\n", p.ModuleName))
+	b.WriteString(fmt.Sprintf("  StructName: %s", p.StructName))
+
+	return b.String()
+}
+
+// NewAddTokenCurrencyProposal creates a AddTokenCurrencyProposal object.
+func NewAddTokenCurrencyProposal(denom string, address sdk.AccAddress, moduleName, structName string) AddTokenCurrencyProposal {
+	return AddTokenCurrencyProposal{
+		Denom:      denom,
+		Address:    address,
+		ModuleName: moduleName,
+		StructName: structName,
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeFreezeCurrency = "FreezeCurrency"
+)
+
+var (
+	_ gov.Content = FreezeCurrencyProposal{}
+)
+
+// FreezeCurrencyProposal is a gov proposal to freeze existing currency.
+// Frozen currency can't be issued, withdrawn, transferred and used by DEX.
+type FreezeCurrencyProposal struct {
+	Denom string
+}
+
+func (p FreezeCurrencyProposal) GetTitle() string       { return "Freeze currency" }
+func (p FreezeCurrencyProposal) GetDescription() string { return "Freezes existing currency" }
+func (p FreezeCurrencyProposal) ProposalRoute() string  { return GovRouterKey }
+func (p FreezeCurrencyProposal) ProposalType() string   { return ProposalTypeFreezeCurrency }
+
+func (p FreezeCurrencyProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	return nil
+}
+
+func (p FreezeCurrencyProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s", p.Denom))
+
+	return b.String()
+}
+
+// NewFreezeCurrencyProposal creates a FreezeCurrencyProposal object.
+func NewFreezeCurrencyProposal(denom string) FreezeCurrencyProposal {
+	return FreezeCurrencyProposal{
+		Denom: denom,
+	}
+}
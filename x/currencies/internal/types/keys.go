@@ -10,6 +10,8 @@ import (
 var (
 	IssuePrefix    = []byte("issue")
 	WithdrawPrefix = []byte("withdraw")
+	UnstakePrefix  = []byte("unstake")
+	UnbanPrefix    = []byte("unban")
 	KeyDelimiter   = []byte(":")
 )
 
@@ -63,3 +65,35 @@ func GetWithdrawKey(id dnTypes.ID) []byte {
 func GetLastWithdrawIDKey() []byte {
 	return []byte("lastWithdrawID")
 }
+
+// GetUnstakeKey returns key for storing unstake.
+func GetUnstakeKey(id string) []byte {
+	return bytes.Join(
+		[][]byte{
+			UnstakePrefix,
+			[]byte(id),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetUnstakesPrefix returns key prefix for unstake objects iteration.
+func GetUnstakesPrefix() []byte {
+	return append(UnstakePrefix, KeyDelimiter...)
+}
+
+// GetUnbanKey returns key for storing unban.
+func GetUnbanKey(id string) []byte {
+	return bytes.Join(
+		[][]byte{
+			UnbanPrefix,
+			[]byte(id),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetUnbansPrefix returns key prefix for unban objects iteration.
+func GetUnbansPrefix() []byte {
+	return append(UnbanPrefix, KeyDelimiter...)
+}
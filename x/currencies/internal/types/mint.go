@@ -1,9 +0,0 @@
-package types
-
-var (
-	// Denom of amount that dnode mints during inflation.
-	MintDenom = "xfi"
-
-	// Inflation event type.
-	MintEventType = "mint"
-)
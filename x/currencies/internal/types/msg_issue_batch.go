@@ -0,0 +1,95 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Max number of MsgIssueCurrencyBatch entries (limits a single multisig call execution cost)
+	IssueBatchMaxEntries = 100
+)
+
+// IssueBatchEntry is a single MsgIssueCurrencyBatch issue.
+type IssueBatchEntry struct {
+	// Issue unique ID (could be txHash of transaction in another blockchain)
+	ID string `json:"id" yaml:"id"`
+	// Target currency issue coin
+	Coin sdk.Coin `json:"coin" yaml:"coin"`
+	// Payee account (whose balance is increased)
+	Payee sdk.AccAddress `json:"payee" yaml:"payee"`
+}
+
+// Client multisig message to issue currencies in batch (all or nothing).
+type MsgIssueCurrencyBatch struct {
+	// Issues to process
+	Entries []IssueBatchEntry `json:"entries" yaml:"entries"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgIssueCurrencyBatch) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgIssueCurrencyBatch) Type() string {
+	return "issue_currency_batch"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgIssueCurrencyBatch) ValidateBasic() error {
+	if len(msg.Entries) == 0 {
+		return sdkErrors.Wrap(ErrWrongIssueBatch, "empty")
+	}
+	if len(msg.Entries) > IssueBatchMaxEntries {
+		return sdkErrors.Wrapf(ErrWrongIssueBatch, "too many entries: %d > %d", len(msg.Entries), IssueBatchMaxEntries)
+	}
+
+	ids := make(map[string]bool, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		if err := NewMsgIssueCurrency(entry.ID, entry.Coin, entry.Payee).ValidateBasic(); err != nil {
+			return sdkErrors.Wrapf(err, "entry [%d]", i)
+		}
+
+		if ids[entry.ID] {
+			return sdkErrors.Wrapf(ErrWrongIssueID, "entry [%d]: issueID %q: duplicated", i, entry.ID)
+		}
+		ids[entry.ID] = true
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgIssueCurrencyBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// Msg is a multisig, so there are not signers.
+func (msg MsgIssueCurrencyBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+// CallUniqueID builds multisig call unique ID based on entries issueIDs.
+func (msg MsgIssueCurrencyBatch) CallUniqueID() string {
+	ids := make([]string, 0, len(msg.Entries))
+	for _, entry := range msg.Entries {
+		ids = append(ids, entry.ID)
+	}
+	hash := sha256.Sum256([]byte(strings.Join(ids, ",")))
+
+	return fmt.Sprintf("batch_%s", hex.EncodeToString(hash[:]))
+}
+
+// NewMsgIssueCurrencyBatch creates a new MsgIssueCurrencyBatch message.
+func NewMsgIssueCurrencyBatch(entries []IssueBatchEntry) MsgIssueCurrencyBatch {
+	return MsgIssueCurrencyBatch{
+		Entries: entries,
+	}
+}
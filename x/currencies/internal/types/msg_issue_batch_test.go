@@ -0,0 +1,78 @@
+// +build unit
+
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test MsgIssueCurrencyBatch ValidateBasic.
+func TestCurrenciesMsg_IssueCurrencyBatch_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	coin := sdk.NewCoin("symbol", sdk.NewInt(10))
+	payee := sdk.AccAddress([]byte("addr1"))
+	target := NewMsgIssueCurrencyBatch([]IssueBatchEntry{
+		{ID: "issue1", Coin: coin, Payee: payee},
+		{ID: "issue2", Coin: coin, Payee: payee},
+	})
+
+	// ok
+	{
+		require.NoError(t, target.ValidateBasic())
+	}
+
+	// invalid: empty
+	{
+		invalidTarget := NewMsgIssueCurrencyBatch(nil)
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+
+	// ok: max entries
+	{
+		entries := make([]IssueBatchEntry, 0, IssueBatchMaxEntries)
+		for i := 0; i < IssueBatchMaxEntries; i++ {
+			entries = append(entries, IssueBatchEntry{ID: fmt.Sprintf("issue%d", i), Coin: coin, Payee: payee})
+		}
+		require.NoError(t, NewMsgIssueCurrencyBatch(entries).ValidateBasic())
+
+		// invalid: too many entries
+		entries = append(entries, IssueBatchEntry{ID: "issueOverflow", Coin: coin, Payee: payee})
+		require.Error(t, NewMsgIssueCurrencyBatch(entries).ValidateBasic())
+	}
+
+	// invalid: entry
+	{
+		invalidTarget := NewMsgIssueCurrencyBatch([]IssueBatchEntry{
+			{ID: "issue1", Coin: coin, Payee: payee},
+			{ID: "issue2", Coin: sdk.Coin{Denom: "symbol", Amount: sdk.ZeroInt()}, Payee: payee},
+		})
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+
+	// invalid: duplicated issueID
+	{
+		invalidTarget := NewMsgIssueCurrencyBatch([]IssueBatchEntry{
+			{ID: "issue1", Coin: coin, Payee: payee},
+			{ID: "issue1", Coin: coin, Payee: payee},
+		})
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+}
+
+// Test MsgIssueCurrencyBatch implements msmodule.MsMsg interface.
+func TestCurrenciesMsg_IssueCurrencyBatch_MsgInterface(t *testing.T) {
+	t.Parallel()
+
+	coin := sdk.NewCoin("symbol", sdk.NewInt(10))
+	target := NewMsgIssueCurrencyBatch([]IssueBatchEntry{{ID: "issue1", Coin: coin, Payee: sdk.AccAddress([]byte("addr1"))}})
+	require.Equal(t, "issue_currency_batch", target.Type())
+	require.Equal(t, RouterKey, target.Route())
+	require.True(t, len(target.GetSignBytes()) > 0)
+	require.Equal(t, 0, len(target.GetSigners()))
+	require.NotEmpty(t, target.CallUniqueID())
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Client multisig message to unban account (revert MsgUnstakeCurrency ban).
+type MsgUnbanAccount struct {
+	// Unban unique ID
+	ID string `json:"id" yaml:"id"`
+	// Target account
+	Account sdk.AccAddress `json:"account" yaml:"account"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgUnbanAccount) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgUnbanAccount) Type() string {
+	return "unban_account"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgUnbanAccount) ValidateBasic() error {
+	if len(msg.ID) == 0 {
+		return sdkErrors.Wrap(ErrWrongUnbanID, "empty")
+	}
+
+	if msg.Account.Empty() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "account: empty")
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgUnbanAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// Msg is a multisig, so there are not signers.
+func (msg MsgUnbanAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+// NewMsgUnbanAccount creates a new MsgUnbanAccount message.
+func NewMsgUnbanAccount(id string, account sdk.AccAddress) MsgUnbanAccount {
+	return MsgUnbanAccount{
+		ID:      id,
+		Account: account,
+	}
+}
@@ -0,0 +1,35 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test MsgUnbanAccount ValidateBasic.
+func TestCurrenciesMsg_UnbanAccount_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	target := NewMsgUnbanAccount("unban1", sdk.AccAddress([]byte("addr1")))
+	// ok
+	{
+		require.NoError(t, target.ValidateBasic())
+	}
+
+	// invalid: id
+	{
+		invalidTarget := target
+		invalidTarget.ID = ""
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+
+	// invalid: account
+	{
+		invalidTarget := target
+		invalidTarget.Account = sdk.AccAddress([]byte{})
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+}
@@ -12,6 +12,9 @@ const (
 	QueryIssue      = "issue"
 	QueryWithdraws  = "withdraws"
 	QueryWithdraw   = "withdraw"
+	QueryUnstake    = "unstake"
+	QueryUnban      = "unban"
+	QueryBanned     = "banned"
 )
 
 // Client request for currency.
@@ -24,6 +27,16 @@ type IssueReq struct {
 	ID string `json:"id" yaml:"id"`
 }
 
+// Client request for unstake.
+type UnstakeReq struct {
+	ID string `json:"id" yaml:"id"`
+}
+
+// Client request for unban.
+type UnbanReq struct {
+	ID string `json:"id" yaml:"id"`
+}
+
 // Client request for withdraw.
 type WithdrawReq struct {
 	ID dnTypes.ID `json:"id" yaml:"id"`
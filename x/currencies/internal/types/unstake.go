@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Unstake is an info about force unstake (staking / liquidity coins burn) for the staker.
+type Unstake struct {
+	// Unstake unique ID (MsgUnstakeCurrency ID)
+	ID string `json:"id" yaml:"id" example:"unstake1"`
+	// Target account
+	Staker sdk.AccAddress `json:"staker" yaml:"staker" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Burned staking / liquidity coins
+	BurnedCoins sdk.Coins `json:"burned_coins" yaml:"burned_coins" swaggertype:"string" example:"100sxfi"`
+	// Unstake block height
+	Height int64 `json:"height" yaml:"height" example:"1"`
+}
+
+// Valid checks that unstake is valid (used for genesis ops).
+func (unstake Unstake) Valid() error {
+	if unstake.ID == "" {
+		return fmt.Errorf("id: empty")
+	}
+
+	if unstake.Staker.Empty() {
+		return fmt.Errorf("staker: empty")
+	}
+
+	if !unstake.BurnedCoins.IsValid() && !unstake.BurnedCoins.Empty() {
+		return fmt.Errorf("burned_coins: invalid")
+	}
+
+	if unstake.Height < 0 {
+		return fmt.Errorf("height: LT zero")
+	}
+
+	return nil
+}
+
+func (unstake Unstake) String() string {
+	return fmt.Sprintf("Unstake:\n"+
+		"  ID:          %s\n"+
+		"  Staker:      %s\n"+
+		"  BurnedCoins: %s\n"+
+		"  Height:      %d",
+		unstake.ID,
+		unstake.Staker.String(),
+		unstake.BurnedCoins.String(),
+		unstake.Height,
+	)
+}
+
+// Unstakes is a slice of Unstake objects.
+type Unstakes []Unstake
+
+// NewUnstake creates a new Unstake object.
+func NewUnstake(id string, staker sdk.AccAddress, burnedCoins sdk.Coins, height int64) Unstake {
+	return Unstake{
+		ID:          id,
+		Staker:      staker,
+		BurnedCoins: burnedCoins,
+		Height:      height,
+	}
+}
+
+// BannedAccount is an info about account banned for staking operations.
+type BannedAccount struct {
+	// Banned account
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Ban block height
+	Height int64 `json:"height" yaml:"height" example:"1"`
+}
+
+func (acc BannedAccount) String() string {
+	return fmt.Sprintf("BannedAccount:\n"+
+		"  Address: %s\n"+
+		"  Height:  %d",
+		acc.Address.String(),
+		acc.Height,
+	)
+}
+
+// BannedAccounts is a slice of BannedAccount objects.
+type BannedAccounts []BannedAccount
+
+// Unban is an info about staking operations ban removal for the account.
+type Unban struct {
+	// Unban unique ID (MsgUnbanAccount ID)
+	ID string `json:"id" yaml:"id" example:"unban1"`
+	// Target account
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Unban block height
+	Height int64 `json:"height" yaml:"height" example:"1"`
+}
+
+// Valid checks that unban is valid (used for genesis ops).
+func (unban Unban) Valid() error {
+	if unban.ID == "" {
+		return fmt.Errorf("id: empty")
+	}
+
+	if unban.Address.Empty() {
+		return fmt.Errorf("address: empty")
+	}
+
+	if unban.Height < 0 {
+		return fmt.Errorf("height: LT zero")
+	}
+
+	return nil
+}
+
+func (unban Unban) String() string {
+	return fmt.Sprintf("Unban:\n"+
+		"  ID:      %s\n"+
+		"  Address: %s\n"+
+		"  Height:  %d",
+		unban.ID,
+		unban.Address.String(),
+		unban.Height,
+	)
+}
+
+// Unbans is a slice of Unban objects.
+type Unbans []Unban
+
+// NewUnban creates a new Unban object.
+func NewUnban(id string, address sdk.AccAddress, height int64) Unban {
+	return Unban{
+		ID:      id,
+		Address: address,
+		Height:  height,
+	}
+}
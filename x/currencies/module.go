@@ -132,11 +132,12 @@ func (app AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // BeginBlock performs module actions at a block start.
-func (app AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, app.ccsKeeper)
-}
+func (app AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock performs module actions at a block end.
-func (app AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// VM-native token currencies supply is synced with VM resources.
+func (app AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	app.ccKeeper.SyncTokenCurrenciesSupply(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
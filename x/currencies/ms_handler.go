@@ -16,23 +16,41 @@ func NewMsHandler(keeper keeper.Keeper) msmodule.MsHandler {
 		case MsgIssueCurrency:
 			return handleMsMsgIssueCurrency(ctx, keeper, msg)
 
+		case MsgIssueCurrencyBatch:
+			return handleMsMsgIssueCurrencyBatch(ctx, keeper, msg)
+
 		case MsgUnstakeCurrency:
 			return handleMsMsgUnstakeCurrency(ctx, keeper, msg)
 
+		case MsgUnbanAccount:
+			return handleMsMsgUnbanAccount(ctx, keeper, msg)
+
 		default:
 			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized %s module multisig msg type: %v", ModuleName, msg.Type())
 		}
 	}
 }
 
+// handleMsMsgUnstakeCurrency hanldes MsgUnstakeCurrency multisig message.
 func handleMsMsgUnstakeCurrency(ctx sdk.Context, keeper keeper.Keeper, msg MsgUnstakeCurrency) error {
-	if err := keeper.UnstakeCurrency(ctx, msg.Staker); err != nil {
+	if err := keeper.UnstakeCurrency(ctx, msg.ID, msg.Staker); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// handleMsMsgUnbanAccount hanldes MsgUnbanAccount multisig message.
+func handleMsMsgUnbanAccount(ctx sdk.Context, keeper keeper.Keeper, msg MsgUnbanAccount) error {
+	if err := keeper.UnbanAccount(ctx, msg.ID, msg.Account); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
 // handleMsMsgIssueCurrency hanldes MsgIssueCurrency multisig message.
 func handleMsMsgIssueCurrency(ctx sdk.Context, keeper keeper.Keeper, msg MsgIssueCurrency) error {
 	if err := keeper.IssueCurrency(ctx, msg.ID, msg.Coin, msg.Payee); err != nil {
@@ -43,3 +61,14 @@ func handleMsMsgIssueCurrency(ctx sdk.Context, keeper keeper.Keeper, msg MsgIssu
 
 	return nil
 }
+
+// handleMsMsgIssueCurrencyBatch hanldes MsgIssueCurrencyBatch multisig message.
+func handleMsMsgIssueCurrencyBatch(ctx sdk.Context, keeper keeper.Keeper, msg MsgIssueCurrencyBatch) error {
+	if err := keeper.IssueCurrencyBatch(ctx, msg.Entries); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
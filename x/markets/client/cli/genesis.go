@@ -53,8 +53,14 @@ func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome stri
 
 			// check if base/quote denom do exist in currencies genesis
 			baseFound, quoteFound := false, false
+			denoms := make([]string, 0, len(genesisCCS.CurrenciesParams)+len(genesisCCS.VMTokens))
 			for _, params := range genesisCCS.CurrenciesParams {
-				denom := params.Denom
+				denoms = append(denoms, params.Denom)
+			}
+			for _, params := range genesisCCS.VMTokens {
+				denoms = append(denoms, params.Denom)
+			}
+			for _, denom := range denoms {
 				if denom == baseDenom {
 					baseFound = true
 					continue
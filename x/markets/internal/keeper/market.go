@@ -89,6 +89,14 @@ func (k Keeper) Add(ctx sdk.Context, baseAsset, quoteAsset string) (types.Market
 		return types.Market{}, sdkErrors.Wrap(types.ErrWrongAssetDenom, "QuoteAsset not registered")
 	}
 
+	// check currencies are not frozen
+	if k.ccsStorage.IsCurrencyFrozen(ctx, baseAsset) {
+		return types.Market{}, sdkErrors.Wrap(types.ErrWrongAssetDenom, "BaseAsset is frozen")
+	}
+	if k.ccsStorage.IsCurrencyFrozen(ctx, quoteAsset) {
+		return types.Market{}, sdkErrors.Wrap(types.ErrWrongAssetDenom, "QuoteAsset is frozen")
+	}
+
 	market := types.NewMarket(k.nextID(ctx), baseAsset, quoteAsset)
 	k.set(ctx, market)
 	k.setLastID(ctx, market.ID)
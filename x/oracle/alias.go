@@ -25,6 +25,7 @@ type (
 	MsgAddAsset        = types.MsgAddAsset
 	MsgSetAsset        = types.MsgSetAsset
 	PostPriceParams    = types.PostPriceParams
+	ResPriceInfo       = types.ResPriceInfo
 )
 
 const (
@@ -61,6 +62,9 @@ var (
 	NewAsset            = types.NewAsset
 	NewMsgPostPrice     = types.NewMsgPostPrice
 	GetAssetCodePath    = types.GetAssetCodePath
+	//
+	NewPriceInfoDSMiddleware  = keeper.NewPriceInfoDSMiddleware
+	GetAssetCodePriceInfoPath = types.GetAssetCodePriceInfoPath
 	// perms requests
 	RequestVMStoragePerms = types.RequestVMStoragePerms
 	// errors
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/lcs"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// NewPriceInfoDSMiddleware creates VM DS server middleware which returns current asset price with its timestamp
+// (0x1::Coins::PriceInfo<Base, Quote> resource, reversed asset codes are supported).
+// Path is matched using the PriceInfo paths map, so non-price paths are skipped without assets iteration.
+// Keeper pointer is used as the middleware is registered on the VM keeper creation (before the oracle keeper is created).
+func NewPriceInfoDSMiddleware(k *Keeper) common_vm.DSDataMiddleware {
+	return func(ctx sdk.Context, path *vm_grpc.VMAccessPath) ([]byte, error) {
+		if !bytes.Equal(path.Address, common_vm.StdLibAddress) {
+			return nil, nil
+		}
+
+		item, found, err := k.getPriceInfoPathAsset(ctx, path.Path)
+		if err != nil || !found {
+			return nil, err
+		}
+
+		price := k.GetCurrentPrice(ctx, item.AssetCode)
+		if price.AssetCode == "" {
+			return nil, nil
+		}
+		if item.Reversed {
+			price = price.GetReversedAssetCurrentPrice()
+		}
+
+		bz, err := lcs.Marshal(types.NewResPriceInfo(price))
+		if err != nil {
+			return nil, fmt.Errorf("price info for %q: lcs marshal: %w", price.AssetCode, err)
+		}
+
+		return bz, nil
+	}
+}
+
+// getPriceInfoPathAsset returns asset matching the PriceInfo VM path.
+// Paths map is rebuilt only if assets params were changed (params might not be initialized yet on genesis).
+func (k Keeper) getPriceInfoPathAsset(ctx sdk.Context, path []byte) (priceInfoPathAsset, bool, error) {
+	assetsBz := k.paramstore.GetRaw(ctx, types.KeyAssets)
+	if assetsBz == nil {
+		return priceInfoPathAsset{}, false, nil
+	}
+
+	k.priceInfoPaths.Lock()
+	defer k.priceInfoPaths.Unlock()
+
+	if !bytes.Equal(k.priceInfoPaths.assetsBz, assetsBz) {
+		paths := make(map[string]priceInfoPathAsset)
+		for _, asset := range k.GetAssetParams(ctx) {
+			for _, assetCode := range []dnTypes.AssetCode{asset.AssetCode, asset.AssetCode.ReverseCode()} {
+				infoPath, err := types.GetAssetCodePriceInfoPath(assetCode)
+				if err != nil {
+					return priceInfoPathAsset{}, false, err
+				}
+				paths[string(infoPath.Path)] = priceInfoPathAsset{AssetCode: asset.AssetCode, Reversed: assetCode != asset.AssetCode}
+			}
+		}
+		k.priceInfoPaths.assetsBz, k.priceInfoPaths.paths = assetsBz, paths
+	}
+
+	item, found := k.priceInfoPaths.paths[string(path)]
+
+	return item, found, nil
+}
+
+// priceInfoPathAsset is a PriceInfo VM path asset (reversed asset code price is served using the direct one).
+type priceInfoPathAsset struct {
+	AssetCode dnTypes.AssetCode
+	Reversed  bool
+}
+
+// priceInfoPathsCache is a PriceInfo VM path to asset map built for the {assetsBz} assets params value.
+type priceInfoPathsCache struct {
+	sync.Mutex
+	assetsBz []byte
+	paths    map[string]priceInfoPathAsset
+}
+
+func newPriceInfoPathsCache() *priceInfoPathsCache {
+	return &priceInfoPathsCache{
+		paths: make(map[string]priceInfoPathAsset),
+	}
+}
@@ -0,0 +1,80 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// Check PriceInfo DS middleware paths matching and the paths map update on assets change.
+func TestOracleKeeper_PriceInfoDSMiddleware(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper := input.keeper
+	ctx := input.ctx
+
+	middleware := NewPriceInfoDSMiddleware(&keeper)
+
+	getPath := func(assetCode dnTypes.AssetCode) *vm_grpc.VMAccessPath {
+		path, err := types.GetAssetCodePriceInfoPath(assetCode)
+		require.NoError(t, err)
+
+		return path
+	}
+
+	checkPriceInfo := func(path *vm_grpc.VMAccessPath, expectedPrice types.CurrentPrice) {
+		bz, err := middleware(ctx, path)
+		require.NoError(t, err)
+
+		expectedBz, err := lcs.Marshal(types.NewResPriceInfo(expectedPrice))
+		require.NoError(t, err)
+		require.Equal(t, expectedBz, bz)
+	}
+
+	// ok: non-stdlib and unknown paths are skipped
+	{
+		path := getPath(input.stdAssetCode)
+
+		bz, err := middleware(ctx, &vm_grpc.VMAccessPath{Address: make([]byte, common_vm.VMAddressLength), Path: path.Path})
+		require.NoError(t, err)
+		require.Nil(t, bz)
+
+		bz, err = middleware(ctx, getPath("eth_usdt"))
+		require.NoError(t, err)
+		require.Nil(t, bz)
+	}
+
+	// ok: current price not set
+	{
+		bz, err := middleware(ctx, getPath(input.stdAssetCode))
+		require.NoError(t, err)
+		require.Nil(t, bz)
+	}
+
+	// ok: direct and reversed asset codes
+	{
+		keeper.addCurrentPrice(ctx, NewMockCurrentPrice(input.stdAssetCode.String(), 100, 99))
+		price := keeper.GetCurrentPrice(ctx, input.stdAssetCode)
+
+		checkPriceInfo(getPath(input.stdAssetCode), price)
+		checkPriceInfo(getPath(input.stdAssetCode.ReverseCode()), price.GetReversedAssetCurrentPrice())
+	}
+
+	// ok: added asset
+	{
+		assetCode := dnTypes.AssetCode("eth_usdt")
+		require.NoError(t, keeper.AddAsset(ctx, input.stdNominee, types.NewAsset(assetCode, []types.Oracle{}, true)))
+
+		keeper.addCurrentPrice(ctx, NewMockCurrentPrice(assetCode.String(), 10, 9))
+		checkPriceInfo(getPath(assetCode), keeper.GetCurrentPrice(ctx, assetCode))
+	}
+}
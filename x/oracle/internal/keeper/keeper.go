@@ -20,6 +20,8 @@ type Keeper struct {
 	paramstore  params.Subspace     // The reference to the Paramstore to get and set oracle specific params
 	vmKeeper    common_vm.VMStorage // Virtual machine keeper
 	modulePerms perms.ModulePermissions
+	//
+	priceInfoPaths *priceInfoPathsCache // PriceInfo VM paths to asset codes map (DS middleware)
 }
 
 // IsNominee checks is nominee exist in the keeper params.
@@ -50,6 +52,8 @@ func NewKeeper(
 		paramstore:  paramStore.WithKeyTable(types.ParamKeyTable()),
 		vmKeeper:    vmKeeper,
 		modulePerms: types.NewModulePerms(),
+		//
+		priceInfoPaths: newPriceInfoPathsCache(),
 	}
 	for _, requester := range permsRequesters {
 		k.modulePerms.AutoAddRequester(requester)
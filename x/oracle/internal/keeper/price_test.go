@@ -7,11 +7,18 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/helpers/tests/utils"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 	"github.com/dfinance/dnode/x/oracle/internal/types"
+	"github.com/dfinance/dnode/x/vm"
+	vmClient "github.com/dfinance/dnode/x/vm/client"
 )
 
 func NewMockCurrentPrice(assetCode string, ask, bid int64) types.CurrentPrice {
@@ -220,3 +227,74 @@ func TestOracleKeeper_CurrentPrice(t *testing.T) {
 	require.Equal(t, cpList[0].AskPrice.Add(cpList[1].AskPrice), price.AskPrice.Add(price2.AskPrice))
 	require.Equal(t, cpList[0].BidPrice.Add(cpList[1].BidPrice), price.BidPrice.Add(price2.BidPrice))
 }
+
+// Check current price VM resources with VM writes (in-process mockDVM backend).
+func TestOracleKeeper_VMPriceResources(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	mockDVM := mockdvm.NewMockDVM()
+
+	// replace test VM storage with VM keeper
+	// extended oracle module perms are used to execute scripts within tests
+	paramsKeeper := params.NewKeeper(input.cdc, input.keyParams, input.tKeyParams)
+	vmKeeper := vm.NewKeeper(input.cdc, input.keyVMS, sdk.NewTransientStoreKey(vm.TStoreKey), paramsKeeper.Subspace(vm.DefaultParamspace), nil, nil, config.DefaultVMConfig(),
+		[]vm.KeeperOption{vm.WithVMClient(mockDVM.Client())},
+		func() (moduleName string, modulePerms perms.Permissions) {
+			moduleName = types.ModuleName
+			modulePerms = perms.Permissions{vmClient.PermStorageRead, vmClient.PermStorageWrite, vmClient.PermVmExec}
+			return
+		},
+	)
+	keeper := NewKeeper(input.cdc, input.keyOracle, paramsKeeper.Subspace(types.DefaultParamspace), vmKeeper)
+	ctx := input.ctx
+	header := ctx.BlockHeader()
+
+	assetCode := input.stdAssetCode
+	signer := input.addresses[0]
+
+	checkVMPrice := func(assetCode dnTypes.AssetCode, price sdk.Int) {
+		path, expectedValue := types.NewResPriceStorageValuesPanic(assetCode, price)
+		require.Equal(t, expectedValue, vmKeeper.GetValue(ctx, path), "asset %s", assetCode)
+	}
+
+	setPrice := func(ask, bid int64) types.CurrentPrice {
+		_, err := keeper.SetPrice(ctx, signer, assetCode, sdk.NewInt(ask), sdk.NewInt(bid), header.Time)
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+
+		return keeper.GetCurrentPrice(ctx, assetCode)
+	}
+
+	// ok: price update writes direct and reversed prices to the VM storage
+	{
+		price := setPrice(100, 99)
+		checkVMPrice(assetCode, price.AskPrice)
+		checkVMPrice(assetCode.ReverseCode(), price.GetReversedAssetCurrentPrice().AskPrice)
+	}
+
+	// ok: script overwrites price resource
+	{
+		path, value := types.NewResPriceStorageValuesPanic(assetCode, sdk.NewInt(1))
+		script := []byte{0x1}
+		mockDVM.SetExecResult(script, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: path, Value: value}}, nil)
+		require.NoError(t, vmKeeper.ExecuteScript(ctx, vm.NewMsgExecuteScript(signer, script, nil)))
+
+		checkVMPrice(assetCode, sdk.NewInt(1))
+	}
+
+	// ok: next price update overwrites the VM written value
+	{
+		price := setPrice(200, 199)
+		checkVMPrice(assetCode, price.AskPrice)
+		checkVMPrice(assetCode.ReverseCode(), price.GetReversedAssetCurrentPrice().AskPrice)
+	}
+
+	// ok: aborted script doesn't change price resources
+	{
+		price := keeper.GetCurrentPrice(ctx, assetCode)
+		require.NoError(t, vmKeeper.ExecuteScript(ctx, vm.NewMsgExecuteScript(signer, []byte{0x2}, nil)))
+
+		checkVMPrice(assetCode, price.AskPrice)
+	}
+}
@@ -47,3 +47,57 @@ func NewResPriceStorageValuesPanic(assetCode dnTypes.AssetCode, price sdk.Int) (
 
 	return key, value
 }
+
+const (
+	// Reserved stdlib struct name for the price info DVM resource (served by the DS middleware)
+	PriceInfoStruct = "PriceInfo"
+)
+
+// ResPriceInfo is a DVM resource, containing current asset prices with the timestamp.
+// Resource is not stored to the VM storage, but computed by the DS data middleware.
+type ResPriceInfo struct {
+	AskPrice   *big.Int
+	BidPrice   *big.Int
+	ReceivedAt uint64
+}
+
+// NewResPriceInfo converts CurrentPrice to ResPriceInfo.
+func NewResPriceInfo(price CurrentPrice) ResPriceInfo {
+	return ResPriceInfo{
+		AskPrice:   price.AskPrice.BigInt(),
+		BidPrice:   price.BidPrice.BigInt(),
+		ReceivedAt: uint64(price.ReceivedAt.Unix()),
+	}
+}
+
+// GetAssetCodePriceInfoPath returns vm_grpc.VMAccessPath for 0x1::Coins::PriceInfo<Base, Quote> DVM resource.
+func GetAssetCodePriceInfoPath(assetCode dnTypes.AssetCode) (*vm_grpc.VMAccessPath, error) {
+	assets := strings.Split(assetCode.String(), string(dnTypes.AssetCodeDelimiter))
+	if len(assets) != 2 {
+		return nil, fmt.Errorf("converting assetCode %q to VMAccessPath: invalid AssetCode", assetCode.String())
+	}
+
+	var stdLibAddress [common_vm.VMAddressLength]byte
+	copy(stdLibAddress[:], common_vm.StdLibAddress)
+
+	typeParams := []glav.TypeParam{
+		getCurrencyTypeParam(stdLibAddress, assets[0]),
+		getCurrencyTypeParam(stdLibAddress, assets[1]),
+	}
+	tag := glav.NewStructTag(stdLibAddress, glav.CoinsModule, PriceInfoStruct, typeParams)
+
+	return &vm_grpc.VMAccessPath{
+		Address: common_vm.StdLibAddress,
+		Path:    tag.AccessVector(),
+	}, nil
+}
+
+// getCurrencyTypeParam returns Move currency type param (0x1::XFI::T or 0x1::Coins::{DENOM}).
+func getCurrencyTypeParam(stdLibAddress [common_vm.VMAddressLength]byte, denom string) glav.TypeParam {
+	denom = strings.ToUpper(denom)
+	if denom == glav.XfiModule {
+		return glav.NewStructTypeParam(glav.NewStructTag(stdLibAddress, glav.XfiModule, glav.XfiStruct, nil))
+	}
+
+	return glav.NewStructTypeParam(glav.NewStructTag(stdLibAddress, glav.CoinsModule, denom, nil))
+}
@@ -54,6 +54,10 @@ func (k Keeper) PostOrder(
 		return types.Order{}, err
 	}
 
+	if market.BaseCurrency.Frozen || market.QuoteCurrency.Frozen {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrFrozenAsset, "market %s", market.ID)
+	}
+
 	id := k.nextID(ctx)
 	order := types.NewOrder(ctx, id, owner, market, direction, price, quantity, ttlInSec)
 	if err := order.ValidatePriceQuantity(); err != nil {
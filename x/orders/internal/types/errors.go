@@ -20,4 +20,6 @@ var (
 	ErrWrongOrderID = sdkErrors.Register(ModuleName, 107, "wrong orderID")
 	// Asset code not exists.
 	ErrWrongAssetCode = sdkErrors.Register(ModuleName, 108, "wrong asset code")
+	// Market currency is frozen.
+	ErrFrozenAsset = sdkErrors.Register(ModuleName, 109, "market asset is frozen")
 )
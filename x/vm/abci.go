@@ -25,6 +25,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper, _ abci.RequestBeginBlock) {
 		switch proposal := pProposal.(type) {
 		case StdlibUpdateProposal:
 			err = handleStdlibUpdateProposalExecution(ctx, k, proposal)
+		case ModulePublishProposal:
+			err = handleModulePublishProposalExecution(ctx, k, proposal)
 		default:
 			panic(fmt.Errorf("unsupported type: %T", pProposal))
 		}
@@ -49,3 +51,13 @@ func handleStdlibUpdateProposalExecution(ctx sdk.Context, k Keeper, proposal Std
 
 	return nil
 }
+
+// handleModulePublishProposalExecution requests DVM to publish modules.
+func handleModulePublishProposalExecution(ctx sdk.Context, k Keeper, proposal ModulePublishProposal) error {
+	msg, _ := getModulePublishMsg(proposal)
+	if err := k.DeployContract(ctx, msg); err != nil {
+		return err
+	}
+
+	return nil
+}
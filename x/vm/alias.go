@@ -7,8 +7,10 @@ import (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
+	Keeper         = keeper.Keeper
+	GenesisState   = types.GenesisState
+	WriteSetFilter = types.WriteSetFilter
+	Params         = types.Params
 	//
 	ScriptArg        = types.ScriptArg
 	MsgDeployModule  = types.MsgDeployModule
@@ -16,23 +18,44 @@ type (
 	//
 	QueryAccessPath = types.ValueReq
 	QueryValueResp  = types.ValueResp
+	SimulateReq     = types.SimulateReq
+	SimulateResp    = types.SimulateResp
+	ResourcesReq    = types.ResourcesReq
+	Resources       = types.Resources
+	ModuleInfo      = types.ModuleInfo
+	ModuleInfos     = types.ModuleInfos
+	EventsReq       = types.EventsReq
+	EventIndexItem  = types.EventIndexItem
+	EventIndexItems = types.EventIndexItems
 	//
 	CurrentTimestamp = middlewares.CurrentTimestamp
 	BlockHeader      = middlewares.BlockHeader
+	ChainInfo        = middlewares.ChainInfo
 	//
-	PlannedProposal      = types.PlannedProposal
-	TestProposal         = types.TestProposal
-	StdlibUpdateProposal = types.StdlibUpdateProposal
+	PlannedProposal         = types.PlannedProposal
+	TestProposal            = types.TestProposal
+	StdlibUpdateProposal    = types.StdlibUpdateProposal
+	ModulePublishProposal   = types.ModulePublishProposal
+	CancelScheduledProposal = types.CancelScheduledProposal
+	ScheduledProposal       = types.ScheduledProposal
+	ScheduledProposals      = types.ScheduledProposals
 	//
 	Contract = types.Contract
+	//
+	DSContextKind = keeper.DSContextKind
+	KeeperOption  = keeper.Option
+	VMClient      = keeper.VMClient
 )
 
 const (
 	ModuleName   = types.ModuleName
 	StoreKey     = types.StoreKey
+	TStoreKey    = types.TStoreKey
 	RouterKey    = types.RouterKey
 	GovRouterKey = types.GovRouterKey
 	//
+	DefaultParamspace = types.DefaultParamspace
+	//
 	// Event types, attribute types and values
 	EventTypeContractStatus = types.EventTypeContractStatus
 	EventTypeMoveEvent      = types.EventTypeMoveEvent
@@ -45,11 +68,19 @@ const (
 	AttributeSender      = types.AttributeVmEventSender
 	AttributeSource      = types.AttributeVmEventSource
 	AttributeData        = types.AttributeVmEventData
+	AttributeDataJSON    = types.AttributeVmEventDataJSON
 	//
 	AttributeValueStatusDiscard = types.AttributeValueStatusDiscard
 	AttributeValueStatusKeep    = types.AttributeValueStatusKeep
 	AttributeValueStatusError   = types.AttributeValueStatusError
 	AttributeValueSourceScript  = types.AttributeValueSourceScript
+	//
+	DSContextDeliver  = keeper.DSContextDeliver
+	DSContextCheck    = keeper.DSContextCheck
+	DSContextQuery    = keeper.DSContextQuery
+	DSContextSimulate = keeper.DSContextSimulate
+	//
+	ModuleSourceMaxLength = types.ModuleSourceMaxLength
 )
 
 var (
@@ -61,9 +92,19 @@ var (
 	NewKeeper           = keeper.NewKeeper
 	NewQuerier          = keeper.NewQuerier
 	DefaultGenesisState = types.DefaultGenesisState
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
 	NewMsgDeployModule  = types.NewMsgDeployModule
+	NewMsgExecuteScript = types.NewMsgExecuteScript
+	WithDSContextKind   = keeper.WithDSContextKind
+	GetDSContextKind    = keeper.GetDSContextKind
+	//
+	WithDSDataMiddlewares = keeper.WithDSDataMiddlewares
+	WithVMClient          = keeper.WithVMClient
+	ChainInfoPath         = middlewares.ChainInfoPath
 	// error aliases
 	ErrInternal           = types.ErrInternal
 	ErrVMCrashed          = types.ErrVMCrashed
+	ErrVMUnavailable      = types.ErrVMUnavailable
 	ErrGovInvalidProposal = types.ErrGovInvalidProposal
 )
@@ -1,7 +1,7 @@
 package cli
 
 import (
-	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -15,7 +15,7 @@ import (
 )
 
 // AddGenesisWSFromFileCmd return genesis cmd which adds writeSets from file.
-// File is generated by DVM stdlib-builder app and contains writeSets of standard library.
+// File is generated by DVM stdlib-builder app (writeSets of standard library) or by the export-vm-write-set cmd.
 func AddGenesisWSFromFileCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "read-genesis-write-set [writeSetJsonFile]",
@@ -34,8 +34,11 @@ func AddGenesisWSFromFileCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Comma
 			}
 
 			var genesisState types.GenesisState
-			if err := json.Unmarshal(jsonContent, &genesisState); err != nil {
-				return err
+			if err := cdc.UnmarshalJSON(jsonContent, &genesisState); err != nil {
+				return helpers.BuildError("writeSetJsonFile", args[0], helpers.ParamTypeCliArg, fmt.Sprintf("JSON unmarshal: %v", err))
+			}
+			if err := genesisState.Validate(); err != nil {
+				return helpers.BuildError("writeSetJsonFile", args[0], helpers.ParamTypeCliArg, fmt.Sprintf("validation: %v", err))
 			}
 
 			// retrieve the app state
@@ -8,11 +8,14 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
-	"github.com/dfinance/dvm-proto/go/compiler_grpc"
+	"github.com/dfinance/glav"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/common_vm"
@@ -71,11 +74,17 @@ func GetData(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetLcsView returns query command that returns LCS view for VM writeSet based on request struct meta.
 func GetLcsView(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	const (
+		flagTypeParams    = "type-params"
+		flagBytesFormat   = "bytes-format"
+		flagAddressFormat = "address-format"
+	)
+
 	cmd := &cobra.Command{
 		Use:     "get-lcs-view [address] [moduleStructMovePath] [viewRequestPath]",
 		Short:   "Get write set data LCS string view for {address}::{moduleName}::{structName} Move path",
-		Example: "get-lcs-view 0x0000000000000000000000000000000000000001 Block::BlockMetadata ./block.json",
-		Args:    cobra.ExactArgs(3),
+		Example: "get-lcs-view 0x0000000000000000000000000000000000000001 Block::BlockMetadata ./block.json --address-format=bech32",
+		Args:    cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -95,22 +104,29 @@ func GetLcsView(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				moduleName, structName = values[0], values[1]
 			}
 
-			viewRequestBz, err := helpers.ParseFilePath("viewRequestPath", args[2], helpers.ParamTypeCliArg)
-			if err != nil {
-				return err
-			}
-
 			var viewRequest types.ViewerRequest
-			if err := json.Unmarshal(viewRequestBz, &viewRequest); err != nil {
-				return fmt.Errorf("viewRequest JSON unmarshal: %v", err)
+			if len(args) > 2 {
+				viewRequestBz, err := helpers.ParseFilePath("viewRequestPath", args[2], helpers.ParamTypeCliArg)
+				if err != nil {
+					return err
+				}
+
+				if err := json.Unmarshal(viewRequestBz, &viewRequest); err != nil {
+					return fmt.Errorf("viewRequest JSON unmarshal: %v", err)
+				}
+			} else if structName == "" {
+				return helpers.BuildError("moduleStructMovePath", args[1], helpers.ParamTypeCliArg, "StructName is required if viewRequestPath is not set")
 			}
 
 			// prepare request
 			bz, err := cdc.MarshalJSON(types.LcsViewReq{
-				Address:     address,
-				ModuleName:  moduleName,
-				StructName:  structName,
-				ViewRequest: viewRequest,
+				Address:       address,
+				ModuleName:    moduleName,
+				StructName:    structName,
+				TypeParams:    viper.GetStringSlice(flagTypeParams),
+				ViewRequest:   viewRequest,
+				BytesFormat:   types.ViewerFormat(viper.GetString(flagBytesFormat)),
+				AddressFormat: types.ViewerFormat(viper.GetString(flagAddressFormat)),
 			})
 			if err != nil {
 				return err
@@ -129,47 +145,227 @@ func GetLcsView(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	helpers.BuildCmdHelp(cmd, []string{
 		"VM address (Bech32 / HEX string)",
 		"Move formatted path (ModuleName::StructName, where ::StructName is optional)",
-		"LCS view JSON formatted request filePath (refer to docs for specs)",
+		"[optional] LCS view JSON formatted request filePath (refer to docs for specs), built from the module metadata if not set",
 	})
+	cmd.Flags().StringSlice(flagTypeParams, nil, "struct type params (comma separated Move types, e.g. 0x1::Coins::ETH,u64)")
+	cmd.Flags().String(flagBytesFormat, "", "default vector<u8> fields format (hex / string), base64 if not set")
+	cmd.Flags().String(flagAddressFormat, "", "default address fields format (hex / bech32), bytes array if not set")
 
 	return cmd
 }
 
-// Compile returns query command that compiles Move script / module.
-func Compile(cdc *codec.Codec) *cobra.Command {
+// GetResources returns query command that lists VM modules and resources stored under the address with pagination.
+func GetResources(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "compile [moveFile] [account]",
-		Short:   "Compile script / module using source code from Move file",
-		Example: "compile script.move wallet196udj7s83uaw2u4safcrvgyqc0sc3flxuherp6 --to-file script.json",
-		Args:    cobra.ExactArgs(2),
+		Use:     "resources [address]",
+		Short:   "Get VM modules and resources stored under the account address",
+		Example: "resources wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --page=1 --limit=10",
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			compilerAddr := viper.GetString(vm_client.FlagCompilerAddr)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			// parse inputs
-			moveContent, err := helpers.ParseFilePath("moveFile", args[0], helpers.ParamTypeCliArg)
+			address, err := helpers.ParseSdkAddressParam("address", args[0], helpers.ParamTypeCliArg)
 			if err != nil {
 				return err
 			}
 
-			address, err := helpers.ParseSdkAddressParam("account", args[1], helpers.ParamTypeCliArg)
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			bz, err := cdc.MarshalJSON(types.ResourcesReq{
+				Address: common_vm.Bech32ToLibra(address),
+				Page:    page,
+				Limit:   limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryResources), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Resources
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"VM address (Bech32 / HEX string)",
+	})
+	helpers.AddPaginationCmdFlags(cmd)
+
+	return cmd
+}
+
+// GetScheduledProposals returns query command that lists VM gov proposals queue.
+func GetScheduledProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposals",
+		Short:   "Get scheduled VM gov proposals (queue ID, type, planned blockHeight)",
+		Example: "proposals",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposals), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.ScheduledProposals
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{})
+
+	return cmd
+}
+
+// GetParams returns query command that prints VM gas schedule params.
+func GetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Get VM gas schedule params (gas unit price, multiplier, max gas, publish gas per byte)",
+		Example: "params",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{})
+
+	return cmd
+}
+
+// GetModules returns query command that lists published modules registry entries for the address.
+func GetModules(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modules [address]",
+		Short:   "Get published modules (publisher, height, code hash, source, ABI) for the address",
+		Example: "modules wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := helpers.ParseSdkAddressParam("address", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			bz, err := cdc.MarshalJSON(types.ModulesReq{
+				Address: common_vm.Bech32ToLibra(address),
+			})
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryModules), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ModuleInfos
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"VM address (Bech32 / HEX string)",
+	})
+
+	return cmd
+}
+
+// GetModule returns query command that returns published module registry entry (with ABI).
+func GetModule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module [address] [moduleName]",
+		Short:   "Get published module info and ABI (structs and functions signatures)",
+		Example: "module 0x0000000000000000000000000000000000000001 Account",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := helpers.ParseSdkAddressParam("address", args[0], helpers.ParamTypeCliArg)
 			if err != nil {
 				return err
 			}
 
 			// prepare request
-			sourceFile := &compiler_grpc.SourceFiles{
-				Units: []*compiler_grpc.CompilationUnit{
-					{
-						Text: string(moveContent),
-						Name: "CompilationUnit",
-					},
-				},
+			bz, err := cdc.MarshalJSON(types.ModuleReq{
 				Address: common_vm.Bech32ToLibra(address),
+				Name:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryModule), bz)
+			if err != nil {
+				return err
 			}
 
-			// compile Move file
-			bytecode, err := vm_client.Compile(compilerAddr, sourceFile)
+			var out types.ModuleInfo
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"VM address (Bech32 / HEX string)",
+		"module name",
+	})
+
+	return cmd
+}
+
+// Compile returns query command that compiles Move script / module.
+func Compile(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "compile [moveSource] [account]",
+		Short:   "Compile scripts / modules using source code from Move file, project directory or project manifest",
+		Example: "compile ./contracts wallet196udj7s83uaw2u4safcrvgyqc0sc3flxuherp6 --to-file contracts.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			compilerAddr := viper.GetString(vm_client.FlagCompilerAddr)
 
+			// parse inputs
+			address, err := helpers.ParseSdkAddressParam("account", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// compile Move project
+			bytecode, err := compileMoveProject(cliCtx, compilerAddr, "moveSource", args[0], address)
 			if err != nil {
 				return err
 			}
@@ -183,9 +379,10 @@ func Compile(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	helpers.BuildCmdHelp(cmd, []string{
-		"path to .move file",
+		fmt.Sprintf("path to .move file, project directory (all .move files or %s manifest) or project manifest (JSON)", vm_client.ProjectManifestName),
 		"account address (Bech32 / HEX string)",
 	})
+	cmd.Flags().Bool(FlagNoCache, false, "compile project ignoring the compilation cache")
 
 	return cmd
 }
@@ -221,6 +418,183 @@ func GetTxVMStatus(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetTxWriteSet returns query command that returns indexed transaction writeSets with decoded values.
+func GetTxWriteSet(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tx-writeset [hash]",
+		Short:   "Get TX VM writeSets by hash (requires node writeSets indexer to be enabled)",
+		Example: "tx-writeset 6D5A4D889BCDB4C71C6AE5836CD8BC1FD8E0703F1580B9812990431D1796CE34",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			_, txHash, err := helpers.ParseHexStringParam("hash", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			bz, err := cdc.MarshalJSON(types.TxWriteSetReq{Hash: txHash})
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTxWriteSet), bz)
+			if err != nil {
+				return err
+			}
+
+			var writeSet types.TxWriteSet
+			if err := cdc.UnmarshalJSON(res, &writeSet); err != nil {
+				return fmt.Errorf("response unmarshal: %w", err)
+			}
+
+			// read values at the TX height and decode them
+			heightCtx := cliCtx.WithHeight(writeSet.Height)
+			out := vm_client.TxWriteSetView{
+				TxHash: writeSet.TxHash,
+				Height: writeSet.Height,
+				Items:  make([]vm_client.TxWriteSetViewItem, 0, len(writeSet.Items)),
+			}
+			for _, item := range writeSet.Items {
+				out.Items = append(out.Items, getTxWriteSetViewItem(heightCtx, queryRoute, item))
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"transaction hash code",
+	})
+
+	return cmd
+}
+
+// GetEvents returns query command that returns indexed VM events filtered by type / sender within the heights range.
+func GetEvents(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	const (
+		flagType       = "type"
+		flagSender     = "sender"
+		flagFromHeight = "from-height"
+		flagToHeight   = "to-height"
+	)
+
+	cmd := &cobra.Command{
+		Use:     "events",
+		Short:   "Get indexed VM events by type / sender within the heights range (requires node events indexer to be enabled)",
+		Example: "events --type=0x1::Account::SentPaymentEvent --sender=wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --from-height=100 --to-height=200 --page=1 --limit=10",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			req := types.EventsReq{
+				EventType: viper.GetString(flagType),
+			}
+
+			if senderStr := viper.GetString(flagSender); senderStr != "" {
+				sender, err := helpers.ParseSdkAddressParam(flagSender, senderStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				req.Sender = types.StringifySenderAddress(common_vm.Bech32ToLibra(sender))
+			}
+
+			if heightStr := viper.GetString(flagFromHeight); heightStr != "" {
+				height, err := helpers.ParseInt64Param(flagFromHeight, heightStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				req.FromHeight = height
+			}
+
+			if heightStr := viper.GetString(flagToHeight); heightStr != "" {
+				height, err := helpers.ParseInt64Param(flagToHeight, heightStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				req.ToHeight = height
+			}
+
+			if err := req.Validate(); err != nil {
+				return err
+			}
+
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			req.Page, req.Limit = page, limit
+
+			// prepare request
+			bz, err := cdc.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEvents), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.EventIndexItems
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{})
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagType, "", "event Move type filter (exact match, as in the event \"type\" attribute)")
+	cmd.Flags().String(flagSender, "", "event sender filter (Bech32 / HEX string)")
+	cmd.Flags().String(flagFromHeight, "", "block height range start (inclusive)")
+	cmd.Flags().String(flagToHeight, "", "block height range end (inclusive)")
+
+	return cmd
+}
+
+// getTxWriteSetViewItem reads writeSet value at the context height and decodes it.
+func getTxWriteSetViewItem(cliCtx context.CLIContext, queryRoute string, item types.WriteSetIndexItem) vm_client.TxWriteSetViewItem {
+	viewItem := vm_client.TxWriteSetViewItem{
+		Op:        item.Op,
+		Address:   item.Address,
+		Path:      item.Path,
+		ValueHash: item.ValueHash,
+	}
+	if item.Op != types.WriteSetOpSet {
+		return viewItem
+	}
+
+	address, _ := hex.DecodeString(item.Address)
+	path, _ := hex.DecodeString(item.Path)
+
+	bz, err := cliCtx.Codec.MarshalJSON(types.ValueReq{Address: address, Path: path})
+	if err != nil {
+		viewItem.Note = err.Error()
+		return viewItem
+	}
+
+	value, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValue), bz)
+	if err != nil {
+		viewItem.Note = fmt.Sprintf("value not available: %v", err)
+		return viewItem
+	}
+	if types.HashWriteSetValue(value) != item.ValueHash {
+		viewItem.Note = "value was changed later in the block"
+		return viewItem
+	}
+
+	viewItem.Value = hex.EncodeToString(value)
+	if decoded, ok := vm_client.DecodeWriteSetValue(cliCtx, address, path, value); ok {
+		viewItem.Decoded = decoded
+	}
+
+	return viewItem
+}
+
 // saveOutput prints compilation output to stdout or file.
 func saveOutput(items []vm_client.CompiledItem, cdc *codec.Codec) error {
 	output := viper.GetString(vm_client.FlagOutput)
@@ -244,3 +618,63 @@ func saveOutput(items []vm_client.CompiledItem, cdc *codec.Codec) error {
 
 	return nil
 }
+
+// compileMoveProject loads Move project, checks that its dependencies are published on-chain and compiles it.
+// Compilation result is cached (key: project sources and dependencies bytecode).
+func compileMoveProject(cliCtx context.CLIContext, compilerAddr, argName, argValue string, sender sdk.AccAddress) (vm_client.CompiledItems, error) {
+	project, err := vm_client.LoadMoveProject(argValue, common_vm.Bech32ToLibra(sender))
+	if err != nil {
+		return nil, helpers.BuildError(argName, argValue, helpers.ParamTypeCliArg, err.Error())
+	}
+
+	depsCode, err := project.ResolveDependencies(func(id vm_client.MoveModuleID) ([]byte, error) {
+		return queryModuleCode(cliCtx, id)
+	})
+	if err != nil {
+		return nil, helpers.BuildError(argName, argValue, helpers.ParamTypeCliArg, err.Error())
+	}
+
+	cache := vm_client.NewCompileCache(viper.GetString(cli.HomeFlag))
+	cacheKey := project.Hash(depsCode)
+	if !viper.GetBool(FlagNoCache) {
+		items, found, err := cache.Get(cacheKey)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return items, nil
+		}
+	}
+
+	items, err := vm_client.CompileProject(compilerAddr, project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.Set(cacheKey, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// queryModuleCode queries published module bytecode (empty if not found).
+func queryModuleCode(cliCtx context.CLIContext, id vm_client.MoveModuleID) ([]byte, error) {
+	var address [common_vm.VMAddressLength]byte
+	copy(address[:], id.Address)
+
+	bz, err := cliCtx.Codec.MarshalJSON(types.ValueReq{
+		Address: id.Address,
+		Path:    glav.ModuleAccessVector(address, id.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryValue), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
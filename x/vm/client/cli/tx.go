@@ -4,9 +4,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govCli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -19,13 +24,20 @@ import (
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
-const argName = "moveFile"
+const (
+	argName        = "moveFile"
+	FlagSource     = "source"
+	FlagArgsFile   = "args-file"
+	FlagTypeParams = "type-params"
+	FlagCoSigners  = "co-signers"
+	FlagNoCache    = "no-cache"
+)
 
 // ExecuteScript returns tx command which executed VM script.
 func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "execute [moveFile] [arg1,arg2,arg3,..]",
-		Short:   "Execute Move script",
+		Short:   "Execute Move script (--dry-run prints gas, VM status, writeSet diff and events without sending a transaction)",
 		Example: "execute ./script.move.json wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 100 true \"my string\" \"68656c6c6f2c20776f726c6421\" #\"XFI_ETH\" --from my_account --fees 10000xfi --gas 500000",
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,26 +55,68 @@ func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			strArgs := args[1:]
+			var coSigners []sdk.AccAddress
+			for _, coSignerStr := range viper.GetStringSlice(FlagCoSigners) {
+				coSigner, err := helpers.ParseSdkAddressParam(FlagCoSigners, coSignerStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+				coSigners = append(coSigners, coSigner)
+			}
+
 			typedArgs, err := vm_client.ExtractArguments(compilerAddr, code[0].ByteCode)
 			if err != nil {
 				return fmt.Errorf("extracting typed args from the code: %w", err)
 			}
 
-			scriptArgs, err := vm_client.ConvertStringScriptArguments(strArgs, typedArgs)
-			if err != nil {
-				return fmt.Errorf("converting input args to typed args: %w", err)
+			typeParams := viper.GetStringSlice(FlagTypeParams)
+			var scriptArgs []types.ScriptArg
+			if argsFilePath := viper.GetString(FlagArgsFile); argsFilePath != "" {
+				if len(args) > 1 {
+					return fmt.Errorf("%s flag and script arguments can't be used together", FlagArgsFile)
+				}
+
+				argsFile, fileArgs, err := getScriptArgsFromFile(argsFilePath)
+				if err != nil {
+					return err
+				}
+				if err := vm_client.CheckScriptArgTypes(fileArgs, typedArgs); err != nil {
+					return fmt.Errorf("checking %s arguments: %w", FlagArgsFile, err)
+				}
+
+				if len(argsFile.TypeParams) > 0 {
+					if len(typeParams) > 0 {
+						return fmt.Errorf("%s flag and %s type params can't be used together", FlagTypeParams, FlagArgsFile)
+					}
+					typeParams = argsFile.TypeParams
+				}
+				scriptArgs = fileArgs
+			} else {
+				scriptArgs, err = vm_client.ConvertStringScriptArguments(args[1:], typedArgs)
+				if err != nil {
+					return fmt.Errorf("converting input args to typed args: %w", err)
+				}
 			}
 			if len(scriptArgs) == 0 {
 				scriptArgs = nil
 			}
+			if len(typeParams) == 0 {
+				typeParams = nil
+			}
 
 			// prepare and send message
 			msg := types.NewMsgExecuteScript(fromAddr, code[0].ByteCode, scriptArgs)
+			msg.TypeParams = typeParams
+			msg.CoSigners = coSigners
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
+			// SDK --dry-run flag: VM simulation result is printed instead of the gas estimation
+			if cliCtx.Simulate {
+				return simulateMsg(cliCtx, types.SimulateReq{ExecuteScript: &msg})
+			}
+
 			cliCtx.WithOutput(os.Stdout)
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
@@ -72,6 +126,9 @@ func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 		"path to compiled Mode file containing bytecode",
 		"space separated VM script arguments (optional)",
 	})
+	cmd.Flags().String(FlagArgsFile, "", "path to JSON file with script type params and typed arguments (replaces space separated arguments)")
+	cmd.Flags().StringSlice(FlagTypeParams, nil, "comma separated script generic type params (0x1::Coins::ETH,0x1::Coins::BTC)")
+	cmd.Flags().StringSlice(FlagCoSigners, nil, "comma separated additional script signer addresses (use with --generate-only and combine-signatures to build multi-signer tx)")
 
 	return cmd
 }
@@ -80,7 +137,7 @@ func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 func DeployContract(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "publish [moveFile]",
-		Short:   "Publish Move module",
+		Short:   "Publish Move modules (--dry-run prints gas, VM status, writeSet diff and events without sending a transaction)",
 		Example: "publish ./my_module.move.json --from my_account --fees 10000xfi --gas 500000",
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -92,25 +149,33 @@ func DeployContract(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			code, err := getMoveCodeFromFileArg(args[0], false)
+			code, err := getModulesCodeFromArg(cliCtx, args[0], fromAddr)
 			if err != nil {
 				return err
 			}
 
 			// prepare and send message
 			msg := types.NewMsgDeployModule(fromAddr, getContractsFromCompiledItems(code))
+			msg.Source = viper.GetString(FlagSource)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
+			// SDK --dry-run flag: VM simulation result is printed instead of the gas estimation
+			if cliCtx.Simulate {
+				return simulateMsg(cliCtx, types.SimulateReq{DeployModule: &msg})
+			}
+
 			cliCtx.WithOutput(os.Stdout)
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
 		},
 	}
 	helpers.BuildCmdHelp(cmd, []string{
-		"path to compiled Mode file containing bytecode",
+		"path to compiled Move file containing bytecode or Move project (.move file, project directory or manifest) to compile",
 	})
+	cmd.Flags().String(FlagSource, "", "optional module source URL / hash stored to the published modules registry")
+	cmd.Flags().Bool(FlagNoCache, false, "compile project ignoring the compilation cache")
 
 	return cmd
 }
@@ -173,6 +238,185 @@ func UpdateStdlibProposal(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// ModulePublishProposal returns tx command which sends governance VM modules publish proposal.
+func ModulePublishProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "publish-modules-proposal [moveFile] [address] [plannedBlockHeight] [sourceUrl] [publishDescription]",
+		Short:   "Submit a DVM modules publish proposal",
+		Example: "publish-modules-proposal ./modules.move.json wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 1000 http://github.com/repo 'Foo module v1' --deposit 10000xfi --from my_account --fees 10000xfi",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			code, err := getMoveCodeFromFileArg(args[0], false)
+			if err != nil {
+				return err
+			}
+
+			address, err := helpers.ParseSdkAddressParam("address", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			plannedBlockHeight, err := helpers.ParseInt64Param("plannedBlockHeight", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			sourceUrl, publishDesc := args[3], args[4]
+
+			// prepare and send message
+			content := types.NewModulePublishProposal(types.NewPlan(plannedBlockHeight), address, sourceUrl, publishDesc, getContractsFromCompiledItems(code))
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to compiled Mode file containing modules bytecode",
+		"modules address (Bech32 / HEX string)",
+		"blockHeight at which publish should occur [int]",
+		"URL containing proposal source code",
+		"proposal description (version, short changelist)",
+	})
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// CancelScheduledProposal returns tx command which sends governance proposal to cancel scheduled VM proposal.
+func CancelScheduledProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scheduled-proposal [proposalID] [reason]",
+		Short:   "Submit a proposal to cancel scheduled VM proposal (ID from the proposals query)",
+		Example: "cancel-scheduled-proposal 1 'critical bug found' --deposit 10000xfi --from my_account --fees 10000xfi",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := helpers.ParseUint64Param("proposalID", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewCancelScheduledProposal(proposalID, args[1])
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"scheduled proposal ID [uint]",
+		"cancel reason",
+	})
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// CombineScriptSignatures returns command which assembles multi-signer tx from offline generated signatures.
+func CombineScriptSignatures(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "combine-signatures [txFile] [signatureFiles...]",
+		Short:   "Assemble multi-signer transaction from signatures generated offline (signatures are ordered by tx signers)",
+		Example: "combine-signatures ./unsigned_tx.json ./signer1_sig.json ./signer2_sig.json > ./signed_tx.json",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// parse inputs
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return helpers.BuildError("txFile", args[0], helpers.ParamTypeCliArg, err.Error())
+			}
+
+			signatures := stdTx.Signatures
+			for i, sigFilePath := range args[1:] {
+				paramName := fmt.Sprintf("signatureFiles[%d]", i)
+				sigContent, err := helpers.ParseFilePath(paramName, sigFilePath, helpers.ParamTypeCliArg)
+				if err != nil {
+					return err
+				}
+
+				var signature auth.StdSignature
+				if err := cdc.UnmarshalJSON(sigContent, &signature); err != nil {
+					return helpers.BuildError(paramName, sigFilePath, helpers.ParamTypeCliArg, fmt.Sprintf("signature JSON unmarshal: %v", err))
+				}
+				if signature.PubKey == nil {
+					return helpers.BuildError(paramName, sigFilePath, helpers.ParamTypeCliArg, "signature pubKey: empty")
+				}
+				signatures = append(signatures, signature)
+			}
+
+			// order signatures by tx signers
+			signers := stdTx.GetSigners()
+			stdTx.Signatures = make([]auth.StdSignature, 0, len(signers))
+			for _, signer := range signers {
+				found := false
+				for _, signature := range signatures {
+					if signer.Equals(sdk.AccAddress(signature.PubKey.Address())) {
+						stdTx.Signatures = append(stdTx.Signatures, signature)
+						found = true
+						break
+					}
+				}
+				if !found {
+					return fmt.Errorf("signature for signer %s: not found", signer)
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(stdTx)
+			if err != nil {
+				return fmt.Errorf("tx JSON marshal: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			return nil
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to generated (--generate-only) transaction file",
+		"space separated signature file paths (generated by every signer with tx sign --signature-only, signatures already included to the tx are kept)",
+	})
+
+	return cmd
+}
+
 // getMoveCodeFromFileArg reads .move file and converts its code field.
 func getMoveCodeFromFileArg(argValue string, oneItem bool) (items vm_client.CompiledItems, retErr error) {
 	jsonContent, err := helpers.ParseFilePath(argName, argValue, helpers.ParamTypeCliArg)
@@ -227,6 +471,62 @@ func getMoveCodeFromFileArg(argValue string, oneItem bool) (items vm_client.Comp
 	return
 }
 
+// getModulesCodeFromArg reads compiled Move file or compiles Move project (all project modules are returned in the dependency order).
+func getModulesCodeFromArg(cliCtx context.CLIContext, argValue string, sender sdk.AccAddress) (vm_client.CompiledItems, error) {
+	if info, err := os.Stat(argValue); err != nil || (!info.IsDir() && !isMoveProjectFile(argValue)) {
+		return getMoveCodeFromFileArg(argValue, false)
+	}
+
+	items, err := compileMoveProject(cliCtx, viper.GetString(vm_client.FlagCompilerAddr), argName, argValue, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make(vm_client.CompiledItems, 0, len(items))
+	for _, item := range items {
+		if item.CodeType == vm_client.CodeTypeModule {
+			modules = append(modules, item)
+		}
+	}
+	if len(modules) == 0 {
+		return nil, helpers.BuildError(argName, argValue, helpers.ParamTypeCliArg, "Move project contains no modules")
+	}
+
+	return modules, nil
+}
+
+// isMoveProjectFile checks if file is a Move source file or a Move project manifest (JSON object, compiled file is a JSON array).
+func isMoveProjectFile(path string) bool {
+	switch filepath.Ext(path) {
+	case vm_client.MoveFileExt:
+		return true
+	case ".json":
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return false
+		}
+
+		return strings.HasPrefix(strings.TrimSpace(string(content)), "{")
+	default:
+		return false
+	}
+}
+
+// getScriptArgsFromFile reads and parses JSON script arguments file.
+func getScriptArgsFromFile(path string) (vm_client.ScriptArgsFile, []types.ScriptArg, error) {
+	jsonContent, err := helpers.ParseFilePath(FlagArgsFile, path, helpers.ParamTypeCliFlag)
+	if err != nil {
+		return vm_client.ScriptArgsFile{}, nil, err
+	}
+
+	argsFile, scriptArgs, err := vm_client.ParseScriptArgsFile(jsonContent)
+	if err != nil {
+		return vm_client.ScriptArgsFile{}, nil, helpers.BuildError(FlagArgsFile, path, helpers.ParamTypeCliFlag, err.Error())
+	}
+
+	return argsFile, scriptArgs, nil
+}
+
 // getContractsFromCompiledItems converts CompiledItems to the []Contract format for publish.
 func getContractsFromCompiledItems(items vm_client.CompiledItems) []types.Contract {
 	contracts := make([]types.Contract, len(items))
@@ -237,3 +537,23 @@ func getContractsFromCompiledItems(items vm_client.CompiledItems) []types.Contra
 
 	return contracts
 }
+
+// simulateMsg sends simulate query and prints the result.
+func simulateMsg(cliCtx context.CLIContext, req types.SimulateReq) error {
+	bz, err := cliCtx.Codec.MarshalJSON(req)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySimulate), bz)
+	if err != nil {
+		return err
+	}
+
+	var out types.SimulateResp
+	if err := cliCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+		return fmt.Errorf("response unmarshal: %w", err)
+	}
+
+	return cliCtx.PrintOutput(out)
+}
@@ -29,6 +29,13 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	commands := sdkClient.GetCommands(
 		cli.GetData(types.ModuleName, cdc),
 		cli.GetLcsView(types.ModuleName, cdc),
+		cli.GetTxWriteSet(types.ModuleName, cdc),
+		cli.GetEvents(types.ModuleName, cdc),
+		cli.GetResources(types.ModuleName, cdc),
+		cli.GetModules(types.ModuleName, cdc),
+		cli.GetModule(types.ModuleName, cdc),
+		cli.GetScheduledProposals(types.ModuleName, cdc),
+		cli.GetParams(types.ModuleName, cdc),
 		cli.GetTxVMStatus(cdc),
 	)
 	commands = append(commands, compileCommands...)
@@ -46,6 +53,7 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 	}
 
 	compileCommands := sdkClient.PostCommands(
+		cli.DeployContract(cdc),
 		cli.ExecuteScript(cdc),
 	)
 	for _, cmd := range compileCommands {
@@ -54,11 +62,12 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 	}
 
 	commands := sdkClient.PostCommands(
-		cli.DeployContract(cdc),
-		sdkClient.LineBreak,
 		cli.UpdateStdlibProposal(cdc),
+		cli.ModulePublishProposal(cdc),
+		cli.CancelScheduledProposal(cdc),
 	)
 	commands = append(commands, compileCommands...)
+	commands = append(commands, cli.CombineScriptSignatures(cdc))
 
 	txCmd.AddCommand(commands...)
 
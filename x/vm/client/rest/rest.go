@@ -25,6 +25,7 @@ const (
 	accountAddrName = "accountAddr"
 	vmPathName      = "vmPath"
 	txHash          = "txHash"
+	moduleName      = "moduleName"
 )
 
 type CompileReq struct {
@@ -40,12 +41,18 @@ type ExecuteScriptReq struct {
 	MoveCode string `json:"move_code" yaml:"move_code" format:"HEX encoded byte code"`
 	// Script arguments
 	MoveArgs []string `json:"move_args" yaml:"move_args" example:"true"`
+	// Optional script generic type params
+	TypeParams []string `json:"type_params" yaml:"type_params" example:"0x1::Coins::ETH"`
+	// Optional additional script signers (tx must be signed by all signers)
+	CoSigners []string `json:"co_signers" yaml:"co_signers" example:"wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8"`
 }
 
 type PublishModuleReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	// Compiled Move code
 	MoveCode []string `json:"move_code" yaml:"move_code" format:"HEX encoded byte code array"`
+	// Optional modules source URL / hash
+	Source string `json:"source" yaml:"source" example:"https://github.com/dfinance/dvm"`
 }
 
 type LcsViewReq struct {
@@ -53,8 +60,14 @@ type LcsViewReq struct {
 	Account string `json:"address" format:"bech32/hex" example:"0x0000000000000000000000000000000000000001"`
 	// Move formatted path (ModuleName::StructName, where ::StructName is optional)
 	MovePath string `json:"move_path" example:"Block::BlockMetadata"`
-	// LCS view JSON formatted request (refer to docs for specs)
+	// Struct type params (Move types)
+	TypeParams []string `json:"type_params" example:"0x1::Coins::ETH"`
+	// LCS view JSON formatted request (refer to docs for specs), built from the module metadata if empty
 	ViewRequest string `json:"view_request" example:"[ { \"name\": \"height\", \"type\": \"U64\" } ]"`
+	// Default vector<u8> fields format (hex / string), base64 if empty
+	BytesFormat string `json:"bytes_format" example:"hex"`
+	// Default address fields format (hex / bech32), bytes array if empty
+	AddressFormat string `json:"address_format" example:"bech32"`
 }
 
 type LcsViewResp struct {
@@ -66,9 +79,17 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/compile", types.ModuleName), compile(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/data/{%s}/{%s}", types.ModuleName, accountAddrName, vmPathName), getData(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/view", types.ModuleName), lcsView(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/resources/{%s}", types.ModuleName, accountAddrName), getResources(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/modules/{%s}", types.ModuleName, accountAddrName), getModules(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/modules/{%s}/{%s}", types.ModuleName, accountAddrName, moduleName), getModule(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/proposals", types.ModuleName), getProposals(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", types.ModuleName), getParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/events", types.ModuleName), getEvents(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/tx/{%s}", types.ModuleName, txHash), getTxVMStatus(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/execute", types.ModuleName), executeScript(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/publish", types.ModuleName), deployModule(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/execute/simulate", types.ModuleName), simulateExecuteScript(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/publish/simulate", types.ModuleName), simulateDeployModule(cliCtx)).Methods("POST")
 }
 
 // Compile godoc
@@ -172,6 +193,271 @@ func getData(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// GetResources godoc
+// @Tags VM
+// @Summary Get account VM resources
+// @Description Get VM modules and resources stored under the account address with pagination
+// @ID vmGetResources
+// @Accept  json
+// @Produce json
+// @Param accountAddr path string true "account address (Libra HEX  Bech32)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespResources
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/resources/{accountAddr} [get]
+func getResources(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		address, err := helpers.ParseSdkAddressParam(accountAddrName, vars[accountAddrName], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, limit, err := helpers.ParsePaginationParams(r.URL.Query().Get("page"), r.URL.Query().Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.ResourcesReq{
+			Address: common_vm.Bech32ToLibra(address),
+			Page:    page,
+			Limit:   limit,
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryResources), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetModules godoc
+// @Tags VM
+// @Summary Get published modules
+// @Description Get published modules registry entries (publisher, height, code hash, source, ABI) for the account address
+// @ID vmGetModules
+// @Accept  json
+// @Produce json
+// @Param accountAddr path string true "account address (Libra HEX  Bech32)"
+// @Success 200 {object} VmRespModules
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/modules/{accountAddr} [get]
+func getModules(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		address, err := helpers.ParseSdkAddressParam(accountAddrName, vars[accountAddrName], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.ModulesReq{
+			Address: common_vm.Bech32ToLibra(address),
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryModules), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetProposals godoc
+// @Tags VM
+// @Summary Get scheduled proposals
+// @Description Get VM gov proposals scheduled for execution (queue ID, type, planned blockHeight)
+// @ID vmGetProposals
+// @Accept  json
+// @Produce json
+// @Success 200 {object} VmRespProposals
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/proposals [get]
+func getProposals(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryProposals), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetParams godoc
+// @Tags VM
+// @Summary Get gas schedule params
+// @Description Get VM gas schedule params (gas unit price, multiplier, max gas, publish gas per byte)
+// @ID vmGetParams
+// @Accept  json
+// @Produce json
+// @Success 200 {object} VmRespParams
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/params [get]
+func getParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetEvents godoc
+// @Tags VM
+// @Summary Get indexed events
+// @Description Get indexed VM events filtered by type / sender within the heights range (requires node events indexer to be enabled)
+// @ID vmGetEvents
+// @Accept  json
+// @Produce json
+// @Param type query string false "event Move type filter (exact match)"
+// @Param sender query string false "event sender filter (Libra HEX  Bech32)"
+// @Param from_height query int false "block height range start (inclusive)"
+// @Param to_height query int false "block height range end (inclusive)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespEvents
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/events [get]
+func getEvents(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		query := r.URL.Query()
+		req := types.EventsReq{
+			EventType: query.Get("type"),
+		}
+
+		if senderStr := query.Get("sender"); senderStr != "" {
+			sender, err := helpers.ParseSdkAddressParam("sender", senderStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			req.Sender = types.StringifySenderAddress(common_vm.Bech32ToLibra(sender))
+		}
+
+		if heightStr := query.Get("from_height"); heightStr != "" {
+			height, err := helpers.ParseInt64Param("from_height", heightStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			req.FromHeight = height
+		}
+
+		if heightStr := query.Get("to_height"); heightStr != "" {
+			height, err := helpers.ParseInt64Param("to_height", heightStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			req.ToHeight = height
+		}
+
+		if err := req.Validate(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, limit, err := helpers.ParsePaginationParams(query.Get("page"), query.Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		req.Page, req.Limit = page, limit
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryEvents), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetModule godoc
+// @Tags VM
+// @Summary Get published module
+// @Description Get published module registry entry with ABI (structs and functions signatures)
+// @ID vmGetModule
+// @Accept  json
+// @Produce json
+// @Param accountAddr path string true "account address (Libra HEX  Bech32)"
+// @Param moduleName path string true "module name"
+// @Success 200 {object} VmRespModule
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/modules/{accountAddr}/{moduleName} [get]
+func getModule(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		address, err := helpers.ParseSdkAddressParam(accountAddrName, vars[accountAddrName], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.ModuleReq{
+			Address: common_vm.Bech32ToLibra(address),
+			Name:    vars[moduleName],
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryModule), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // LCSView godoc
 // @Tags VM
 // @Summary Get writeSet data from VM LCS string view
@@ -212,17 +498,22 @@ func lcsView(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		var viewRequest types.ViewerRequest
-		if err := json.Unmarshal([]byte(req.ViewRequest), &viewRequest); err != nil {
-			err := helpers.BuildError("view_request", "", helpers.ParamTypeRestRequest, fmt.Sprintf("JSON unmarshal: %v", err))
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+		if req.ViewRequest != "" {
+			if err := json.Unmarshal([]byte(req.ViewRequest), &viewRequest); err != nil {
+				err := helpers.BuildError("view_request", "", helpers.ParamTypeRestRequest, fmt.Sprintf("JSON unmarshal: %v", err))
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
 		}
 
 		bz, err := cliCtx.Codec.MarshalJSON(types.LcsViewReq{
-			Address:     address,
-			ModuleName:  moduleName,
-			StructName:  structName,
-			ViewRequest: viewRequest,
+			Address:       address,
+			ModuleName:    moduleName,
+			StructName:    structName,
+			TypeParams:    req.TypeParams,
+			ViewRequest:   viewRequest,
+			BytesFormat:   types.ViewerFormat(req.BytesFormat),
+			AddressFormat: types.ViewerFormat(req.AddressFormat),
 		})
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -299,8 +590,6 @@ func getTxVMStatus(cliCtx context.CLIContext) http.HandlerFunc {
 // @Router /vm/execute [put]
 func executeScript(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		compilerAddr := viper.GetString(vm_client.FlagCompilerAddr)
-
 		// parse inputs
 		var req ExecuteScriptReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
@@ -313,69 +602,101 @@ func executeScript(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		// create the message
+		msg, ok := buildExecuteScriptMsg(w, baseReq, req)
+		if !ok {
 			return
 		}
 
-		_, code, err := helpers.ParseHexStringParam("move_code", req.MoveCode, helpers.ParamTypeRestRequest)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// GetIssue godoc
+// @Tags VM
+// @Summary Publish Move module
+// @Description Get publish Move module stdTx object
+// @ID vmDeployModule
+// @Accept  json
+// @Produce json
+// @Param request body PublishModuleReq true "Publish request"
+// @Success 200 {object} VmRespStdTx
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/publish [put]
+func deployModule(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req PublishModuleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
 			return
 		}
 
-		typedArgs, err := vm_client.ExtractArguments(compilerAddr, code)
-		if err != nil {
-			retErr := helpers.BuildError(
-				"move_args",
-				strings.Join(req.MoveArgs, ", "),
-				helpers.ParamTypeRestRequest,
-				fmt.Sprintf("extracting typed args from the code: %v", err),
-			)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, retErr.Error())
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
 			return
 		}
 
-		scriptArgs, err := vm_client.ConvertStringScriptArguments(req.MoveArgs, typedArgs)
-		if err != nil {
-			retErr := helpers.BuildError(
-				"move_args",
-				strings.Join(req.MoveArgs, ", "),
-				helpers.ParamTypeRestRequest,
-				fmt.Sprintf("converting input args to typed args: %v", err),
-			)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, retErr.Error())
+		// create the message
+		msg, ok := buildDeployModuleMsg(w, baseReq, req)
+		if !ok {
 			return
 		}
-		if len(scriptArgs) == 0 {
-			scriptArgs = nil
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// SimulateExecuteScript godoc
+// @Tags VM
+// @Summary Simulate Move script execution
+// @Description Execute Move script against the current state without changing it (dry-run)
+// @ID vmSimulateExecuteScript
+// @Accept  json
+// @Produce json
+// @Param request body ExecuteScriptReq true "Execute request"
+// @Success 200 {object} VmRespSimulate
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/execute/simulate [post]
+func simulateExecuteScript(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req ExecuteScriptReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
 		}
 
 		// create the message
-		msg := types.NewMsgExecuteScript(fromAddr, code, scriptArgs)
-		if err := msg.ValidateBasic(); err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		msg, ok := buildExecuteScriptMsg(w, baseReq, req)
+		if !ok {
 			return
 		}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeSimulateResponse(w, cliCtx, types.SimulateReq{ExecuteScript: &msg})
 	}
 }
 
-// GetIssue godoc
+// SimulateDeployModule godoc
 // @Tags VM
-// @Summary Publish Move module
-// @Description Get publish Move module stdTx object
-// @ID vmDeployModule
+// @Summary Simulate Move module publish
+// @Description Publish Move module against the current state without changing it (dry-run)
+// @ID vmSimulateDeployModule
 // @Accept  json
 // @Produce json
 // @Param request body PublishModuleReq true "Publish request"
-// @Success 200 {object} VmRespStdTx
+// @Success 200 {object} VmRespSimulate
 // @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
 // @Failure 500 {object} rest.ErrorResponse "Returned on server error"
-// @Router /vm/publish [put]
-func deployModule(cliCtx context.CLIContext) http.HandlerFunc {
+// @Router /vm/publish/simulate [post]
+func simulateDeployModule(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// parse inputs
 		var req PublishModuleReq
@@ -389,28 +710,129 @@ func deployModule(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		// create the message
+		msg, ok := buildDeployModuleMsg(w, baseReq, req)
+		if !ok {
+			return
+		}
+
+		writeSimulateResponse(w, cliCtx, types.SimulateReq{DeployModule: &msg})
+	}
+}
+
+// buildExecuteScriptMsg parses ExecuteScriptReq and builds the message (writes error response on failure).
+func buildExecuteScriptMsg(w http.ResponseWriter, baseReq rest.BaseReq, req ExecuteScriptReq) (msg types.MsgExecuteScript, ok bool) {
+	compilerAddr := viper.GetString(vm_client.FlagCompilerAddr)
+
+	fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, code, err := helpers.ParseHexStringParam("move_code", req.MoveCode, helpers.ParamTypeRestRequest)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	typedArgs, err := vm_client.ExtractArguments(compilerAddr, code)
+	if err != nil {
+		retErr := helpers.BuildError(
+			"move_args",
+			strings.Join(req.MoveArgs, ", "),
+			helpers.ParamTypeRestRequest,
+			fmt.Sprintf("extracting typed args from the code: %v", err),
+		)
+		rest.WriteErrorResponse(w, http.StatusBadRequest, retErr.Error())
+		return
+	}
+
+	scriptArgs, err := vm_client.ConvertStringScriptArguments(req.MoveArgs, typedArgs)
+	if err != nil {
+		retErr := helpers.BuildError(
+			"move_args",
+			strings.Join(req.MoveArgs, ", "),
+			helpers.ParamTypeRestRequest,
+			fmt.Sprintf("converting input args to typed args: %v", err),
+		)
+		rest.WriteErrorResponse(w, http.StatusBadRequest, retErr.Error())
+		return
+	}
+	if len(scriptArgs) == 0 {
+		scriptArgs = nil
+	}
+
+	var coSigners []sdk.AccAddress
+	for i, coSignerStr := range req.CoSigners {
+		coSigner, err := helpers.ParseSdkAddressParam(fmt.Sprintf("co_signers[%d]", i), coSignerStr, helpers.ParamTypeRestRequest)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
+		coSigners = append(coSigners, coSigner)
+	}
 
-		contracts := make([]types.Contract, len(req.MoveCode))
-		for i, code := range req.MoveCode {
-			_, contracts[i], err = helpers.ParseHexStringParam("move_code", code, helpers.ParamTypeRestRequest)
-			if err != nil {
-				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
-		}
+	msg = types.NewMsgExecuteScript(fromAddr, code, scriptArgs)
+	msg.CoSigners = coSigners
+	if len(req.TypeParams) > 0 {
+		msg.TypeParams = req.TypeParams
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-		// create the message
-		msg := types.NewMsgDeployModule(fromAddr, contracts)
-		if err := msg.ValidateBasic(); err != nil {
+	return msg, true
+}
+
+// buildDeployModuleMsg parses PublishModuleReq and builds the message (writes error response on failure).
+func buildDeployModuleMsg(w http.ResponseWriter, baseReq rest.BaseReq, req PublishModuleReq) (msg types.MsgDeployModule, ok bool) {
+	fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	contracts := make([]types.Contract, len(req.MoveCode))
+	for i, code := range req.MoveCode {
+		_, contracts[i], err = helpers.ParseHexStringParam("move_code", code, helpers.ParamTypeRestRequest)
+		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
+	}
 
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	msg = types.NewMsgDeployModule(fromAddr, contracts)
+	msg.Source = req.Source
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
+
+	return msg, true
+}
+
+// writeSimulateResponse sends simulate query and writes the response.
+func writeSimulateResponse(w http.ResponseWriter, cliCtx context.CLIContext, req types.SimulateReq) {
+	bz, err := cliCtx.Codec.MarshalJSON(req)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySimulate), bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var resp types.SimulateResp
+	if err := cliCtx.Codec.UnmarshalJSON(res, &resp); err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, resp)
 }
@@ -29,6 +29,41 @@ type (
 		Result auth.StdTx `json:"result"`
 	}
 
+	VmRespSimulate struct {
+		Height int64              `json:"height"`
+		Result types.SimulateResp `json:"result"`
+	}
+
+	VmRespResources struct {
+		Height int64           `json:"height"`
+		Result types.Resources `json:"result"`
+	}
+
+	VmRespModules struct {
+		Height int64             `json:"height"`
+		Result types.ModuleInfos `json:"result"`
+	}
+
+	VmRespModule struct {
+		Height int64            `json:"height"`
+		Result types.ModuleInfo `json:"result"`
+	}
+
+	VmRespProposals struct {
+		Height int64                    `json:"height"`
+		Result types.ScheduledProposals `json:"result"`
+	}
+
+	VmRespParams struct {
+		Height int64        `json:"height"`
+		Result types.Params `json:"result"`
+	}
+
+	VmRespEvents struct {
+		Height int64                 `json:"height"`
+		Result types.EventIndexItems `json:"result"`
+	}
+
 	VmRespLcsView struct {
 		Height int64       `json:"height"`
 		Result LcsViewResp `json:"result"`
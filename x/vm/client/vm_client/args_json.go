@@ -0,0 +1,153 @@
+package vm_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dfinance/dvm-proto/go/types_grpc"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// ScriptArgsFile is a JSON formatted script type params and arguments file.
+type ScriptArgsFile struct {
+	// Script generic type params (Move struct types like 0x1::Coins::ETH)
+	TypeParams []string `json:"type_params"`
+	// Script arguments
+	Args []ScriptArgJSON `json:"args"`
+}
+
+// ScriptArgJSON is a JSON formatted script argument.
+type ScriptArgJSON struct {
+	// Move type: u8, u64, u128, bool, address, vector<u8>
+	Type string `json:"type"`
+	// String / number / bool for scalar types, HEX string or array for vector<u8>
+	Value json.RawMessage `json:"value"`
+}
+
+// ParseScriptArgsFile parses JSON formatted script arguments file content.
+func ParseScriptArgsFile(bz []byte) (ScriptArgsFile, []types.ScriptArg, error) {
+	var file ScriptArgsFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return ScriptArgsFile{}, nil, fmt.Errorf("JSON unmarshal: %w", err)
+	}
+
+	scriptArgs, err := ConvertJSONScriptArguments(file.Args)
+	if err != nil {
+		return ScriptArgsFile{}, nil, err
+	}
+
+	return file, scriptArgs, nil
+}
+
+// ConvertJSONScriptArguments converts JSON formatted arguments to ScriptArgs.
+func ConvertJSONScriptArguments(args []ScriptArgJSON) ([]types.ScriptArg, error) {
+	scriptArgs := make([]types.ScriptArg, 0, len(args))
+	for argIdx, arg := range args {
+		scriptArg, err := NewScriptArgFromJSON(arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument[%d]: %w", argIdx, err)
+		}
+		scriptArgs = append(scriptArgs, scriptArg)
+	}
+
+	return scriptArgs, nil
+}
+
+// NewScriptArgFromJSON converts JSON formatted argument to ScriptArg.
+// Only vector<u8> vectors are supported as the VM Vector type tag is a vector of bytes.
+func NewScriptArgFromJSON(arg ScriptArgJSON) (types.ScriptArg, error) {
+	argType, err := types.ParseMoveType(arg.Type, nil)
+	if err != nil {
+		return types.ScriptArg{}, err
+	}
+
+	if argType.Kind != types.ViewerTypeVector {
+		value, err := getJSONScalarValue(arg.Value)
+		if err != nil {
+			return types.ScriptArg{}, fmt.Errorf("%s: %w", arg.Type, err)
+		}
+
+		return newScalarScriptArg(argType, value)
+	}
+
+	if argType.TypeArgs[0].Kind != types.ViewerTypeU8 {
+		return types.ScriptArg{}, fmt.Errorf("%s: unsupported argument type: only vector<u8> vectors are supported", arg.Type)
+	}
+
+	value, err := encodeLCSBytes(arg.Value)
+	if err != nil {
+		return types.ScriptArg{}, fmt.Errorf("%s: %w", arg.Type, err)
+	}
+
+	return types.ScriptArg{Type: types_grpc.VMTypeTag_Vector, Value: value}, nil
+}
+
+// CheckScriptArgTypes compares arguments with the script arguments types (from the compiler metadata).
+func CheckScriptArgTypes(args []types.ScriptArg, argTypes []types_grpc.VMTypeTag) error {
+	if len(args) != len(argTypes) {
+		return fmt.Errorf("args / script args length mismatch: %d / %d", len(args), len(argTypes))
+	}
+
+	for argIdx, arg := range args {
+		if arg.Type != argTypes[argIdx] {
+			return fmt.Errorf("argument[%d]: type mismatch: %s / %s", argIdx, arg.Type, argTypes[argIdx])
+		}
+	}
+
+	return nil
+}
+
+// newScalarScriptArg converts string value to ScriptArg for non-vector types.
+func newScalarScriptArg(argType types.MoveType, value string) (types.ScriptArg, error) {
+	switch argType.Kind {
+	case types.ViewerTypeU8:
+		return NewU8ScriptArg(value)
+	case types.ViewerTypeU64:
+		return NewU64ScriptArg(value)
+	case types.ViewerTypeU128:
+		return NewU128ScriptArg(value)
+	case types.ViewerTypeBool:
+		return NewBoolScriptArg(value)
+	case types.ViewerTypeAddress:
+		return NewAddressScriptArg(value)
+	}
+
+	return types.ScriptArg{}, fmt.Errorf("%s: unsupported argument type", argType.String())
+}
+
+// encodeLCSBytes converts vector<u8> value (HEX string or numbers array) to bytes.
+func encodeLCSBytes(value json.RawMessage) ([]byte, error) {
+	var hexValue string
+	if err := json.Unmarshal(value, &hexValue); err == nil {
+		arg, err := NewVectorScriptArg(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		return arg.Value, nil
+	}
+
+	var elems []uint8
+	if err := json.Unmarshal(value, &elems); err != nil {
+		return nil, fmt.Errorf("HEX string / u8 array expected: %w", err)
+	}
+
+	return elems, nil
+}
+
+// getJSONScalarValue converts JSON string / number / bool to string.
+func getJSONScalarValue(value json.RawMessage) (string, error) {
+	var strValue string
+	if err := json.Unmarshal(value, &strValue); err == nil {
+		return strValue, nil
+	}
+
+	rawValue := strings.TrimSpace(string(value))
+	if rawValue == "" || strings.HasPrefix(rawValue, "[") || strings.HasPrefix(rawValue, "{") || rawValue == "null" {
+		return "", fmt.Errorf("string / number / bool value expected")
+	}
+
+	return rawValue, nil
+}
@@ -0,0 +1,93 @@
+// +build unit
+
+package vm_client
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/types_grpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+func Test_ParseScriptArgsFile(t *testing.T) {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// ok
+	{
+		content := `{
+			"type_params": ["0x1::Coins::ETH"],
+			"args": [
+				{"type": "u8", "value": 1},
+				{"type": "u64", "value": "2"},
+				{"type": "bool", "value": true},
+				{"type": "address", "value": "` + addr1.String() + `"},
+				{"type": "vector<u8>", "value": "0x0102"},
+				{"type": "vector<u8>", "value": [3, 4]}
+			]
+		}`
+
+		file, args, err := ParseScriptArgsFile([]byte(content))
+		require.NoError(t, err)
+		require.Equal(t, []string{"0x1::Coins::ETH"}, file.TypeParams)
+		require.Len(t, args, 6)
+
+		require.Equal(t, types_grpc.VMTypeTag_U8, args[0].Type)
+		require.Equal(t, []byte{1}, args[0].Value)
+		require.Equal(t, types_grpc.VMTypeTag_U64, args[1].Type)
+		require.Equal(t, []byte{2, 0, 0, 0, 0, 0, 0, 0}, args[1].Value)
+		require.Equal(t, types_grpc.VMTypeTag_Bool, args[2].Type)
+		require.Equal(t, types_grpc.VMTypeTag_Address, args[3].Type)
+		require.Equal(t, common_vm.Bech32ToLibra(addr1), args[3].Value)
+		require.Equal(t, types_grpc.VMTypeTag_Vector, args[4].Type)
+		require.Equal(t, []byte{1, 2}, args[4].Value)
+		require.Equal(t, types_grpc.VMTypeTag_Vector, args[5].Type)
+		require.Equal(t, []byte{3, 4}, args[5].Value)
+	}
+
+	// invalid JSON
+	{
+		_, _, err := ParseScriptArgsFile([]byte(`{"args": [}`))
+		require.Error(t, err)
+	}
+
+	// unsupported type
+	{
+		_, _, err := ParseScriptArgsFile([]byte(`{"args": [{"type": "0x1::Coins::ETH", "value": "1"}]}`))
+		require.Error(t, err)
+	}
+
+	// array for scalar
+	{
+		_, _, err := ParseScriptArgsFile([]byte(`{"args": [{"type": "u64", "value": [1]}]}`))
+		require.Error(t, err)
+	}
+
+	// invalid vector element
+	{
+		_, _, err := ParseScriptArgsFile([]byte(`{"args": [{"type": "vector<u8>", "value": [256]}]}`))
+		require.Error(t, err)
+	}
+
+	// non-u8 vectors (VM Vector type tag is a vector of bytes)
+	for _, argType := range []string{"vector<address>", "vector<u64>", "vector<vector<u8>>"} {
+		_, _, err := ParseScriptArgsFile([]byte(`{"args": [{"type": "` + argType + `", "value": []}]}`))
+		require.Error(t, err, argType)
+		require.Contains(t, err.Error(), "only vector<u8> vectors are supported", argType)
+	}
+}
+
+func Test_CheckScriptArgTypes(t *testing.T) {
+	args := []types.ScriptArg{
+		{Type: types_grpc.VMTypeTag_U64},
+		{Type: types_grpc.VMTypeTag_Vector},
+	}
+
+	require.NoError(t, CheckScriptArgTypes(args, []types_grpc.VMTypeTag{types_grpc.VMTypeTag_U64, types_grpc.VMTypeTag_Vector}))
+	require.Error(t, CheckScriptArgTypes(args, []types_grpc.VMTypeTag{types_grpc.VMTypeTag_U64}))
+	require.Error(t, CheckScriptArgTypes(args, []types_grpc.VMTypeTag{types_grpc.VMTypeTag_U64, types_grpc.VMTypeTag_Address}))
+}
@@ -0,0 +1,75 @@
+package vm_client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	CompileCacheDirName = "vm_compile_cache"
+)
+
+// CompileCache stores compiled Move projects on disk (key: project hash).
+type CompileCache struct {
+	dir string
+}
+
+// Get returns cached compiled items (false if not found).
+func (c CompileCache) Get(key string) (CompiledItems, bool, error) {
+	bz, err := ioutil.ReadFile(c.filePath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("compile cache %q: file read: %w", key, err)
+	}
+
+	var items CompiledItems
+	if err := json.Unmarshal(bz, &items); err != nil {
+		return nil, false, fmt.Errorf("compile cache %q: JSON unmarshal: %w", key, err)
+	}
+
+	for i, item := range items {
+		code, err := hex.DecodeString(item.Code)
+		if err != nil {
+			return nil, false, fmt.Errorf("compile cache %q: item %q: code HEX decode: %w", key, item.Name, err)
+		}
+		items[i].ByteCode = code
+	}
+
+	return items, true, nil
+}
+
+// Set stores compiled items.
+func (c CompileCache) Set(key string, items CompiledItems) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("compile cache: directory create: %w", err)
+	}
+
+	bz, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("compile cache %q: JSON marshal: %w", key, err)
+	}
+
+	if err := ioutil.WriteFile(c.filePath(key), bz, 0644); err != nil {
+		return fmt.Errorf("compile cache %q: file write: %w", key, err)
+	}
+
+	return nil
+}
+
+// filePath returns cache entry file path.
+func (c CompileCache) filePath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// NewCompileCache creates a new CompileCache within the {homeDir}.
+func NewCompileCache(homeDir string) CompileCache {
+	return CompileCache{
+		dir: filepath.Join(homeDir, CompileCacheDirName),
+	}
+}
@@ -98,6 +98,21 @@ func Compile(addr string, sourceFiles *compiler_grpc.SourceFiles) ([]CompiledIte
 	return resp, nil
 }
 
+// CompileProject compiles all Move project sources within a single compiler request.
+// Result items are validated against the project declarations and ordered: modules in the dependency order, then scripts.
+func CompileProject(addr string, project MoveProject) (CompiledItems, error) {
+	items, err := Compile(addr, project.SourceFiles())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := project.ValidateCompiledItems(items); err != nil {
+		return nil, fmt.Errorf("compilation result validation: %w", err)
+	}
+
+	return project.SortCompiledItems(items), nil
+}
+
 // MatchProtoFields decorates protobuf structures for using custom fields.
 func MatchProtoFields(meta *metadata_grpc.ModuleMeta) (types []ModuleType, methods []ModuleMethod) {
 	if meta == nil {
@@ -0,0 +1,597 @@
+package vm_client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/compiler_grpc"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	MoveFileExt         = ".move"
+	ProjectManifestName = "move_project.json"
+	//
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+var (
+	// Move source preprocessing: comments and string literals (might contain braces) are removed before parsing
+	moveBlockCommentRe = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	moveLineCommentRe  = regexp.MustCompile(`//[^\n]*`)
+	moveStringRe       = regexp.MustCompile(`(?:b"(?:[^"\\]|\\.)*")|(?:x"[0-9a-fA-F]*")`)
+	// Move source tokens: address block, module / script declaration, qualified module reference and braces.
+	// Bech32 address literal is matched as a whole word with the data part within Bech32 charset
+	// (at least 6 checksum chars), so identifiers like "vec1" are not taken for an address.
+	moveTokenRe = regexp.MustCompile(
+		`\baddress\s+(0x[0-9a-fA-F]+|[a-z]+1[` + bech32Charset + `]{6,})\s*\{` +
+			`|\bmodule\s+([A-Za-z_]\w*)\s*\{` +
+			`|\b(script)\s*\{` +
+			`|\b(0x[0-9a-fA-F]+|[a-z]+1[` + bech32Charset + `]{6,})::([A-Za-z_]\w*)` +
+			`|([{}])`,
+	)
+)
+
+// MoveProjectManifest is a JSON Move project description.
+type MoveProjectManifest struct {
+	// Project name
+	Name string `json:"name"`
+	// Source .move files and directories (relative to the manifest directory)
+	Sources []string `json:"sources"`
+}
+
+// MoveModuleID identifies Move module by its VM address and name.
+type MoveModuleID struct {
+	Address []byte
+	Name    string
+}
+
+// String returns unique module ID string.
+func (id MoveModuleID) String() string {
+	return fmt.Sprintf("0x%s::%s", hex.EncodeToString(id.Address), id.Name)
+}
+
+// MoveModule is a Move module declaration.
+type MoveModule struct {
+	MoveModuleID
+	// Modules referenced within the module
+	Imports []MoveModuleID
+}
+
+// MoveSource is a parsed Move source file.
+type MoveSource struct {
+	Path string
+	Text string
+	// Modules declared within the file
+	Modules []MoveModule
+	// Modules referenced within the file (modules and scripts)
+	Imports []MoveModuleID
+	// Number of scripts declared within the file
+	Scripts int
+}
+
+// MoveProject is a set of Move source files compiled together.
+type MoveProject struct {
+	// Account address the project is compiled for
+	Sender []byte
+	// Source files in the compilation order (files with modules in the dependency order, script only files last)
+	Sources []MoveSource
+	// Project modules in the dependency order
+	Modules []MoveModuleID
+	// Referenced modules not declared within the project (must be published on-chain), sorted
+	Dependencies []MoveModuleID
+}
+
+// ModuleFetcher returns on-chain module bytecode (nil if module was not published).
+type ModuleFetcher func(id MoveModuleID) ([]byte, error)
+
+// SourceFiles builds the compiler request.
+func (p MoveProject) SourceFiles() *compiler_grpc.SourceFiles {
+	units := make([]*compiler_grpc.CompilationUnit, 0, len(p.Sources))
+	for _, source := range p.Sources {
+		units = append(units, &compiler_grpc.CompilationUnit{
+			Text: source.Text,
+			Name: source.Path,
+		})
+	}
+
+	return &compiler_grpc.SourceFiles{
+		Units:   units,
+		Address: p.Sender,
+	}
+}
+
+// ResolveDependencies fetches on-chain bytecode for every project dependency (in the Dependencies order).
+func (p MoveProject) ResolveDependencies(fetcher ModuleFetcher) ([][]byte, error) {
+	codes := make([][]byte, 0, len(p.Dependencies))
+	missing := make([]string, 0)
+
+	for _, id := range p.Dependencies {
+		code, err := fetcher(id)
+		if err != nil {
+			return nil, fmt.Errorf("fetching dependency %s: %w", id, err)
+		}
+		if len(code) == 0 {
+			missing = append(missing, id.String())
+			continue
+		}
+		codes = append(codes, code)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("dependencies are not published on-chain: %s", strings.Join(missing, ", "))
+	}
+
+	return codes, nil
+}
+
+// Hash returns project hash based on the sender, source files and dependencies bytecode.
+func (p MoveProject) Hash(dependencies [][]byte) string {
+	hasher := sha256.New()
+	writeField := func(bz []byte) {
+		hasher.Write([]byte(fmt.Sprintf("%d:", len(bz))))
+		hasher.Write(bz)
+	}
+
+	writeField(p.Sender)
+	for _, source := range p.Sources {
+		writeField([]byte(source.Path))
+		writeField([]byte(source.Text))
+	}
+	for _, code := range dependencies {
+		writeField(code)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// ValidateCompiledItems checks that compiled items match the parsed project declarations.
+// Project modules order relies on the source parsing, so any mismatch with the compiler output is reported.
+func (p MoveProject) ValidateCompiledItems(items CompiledItems) error {
+	expectedModules := make(map[string]int, len(p.Modules))
+	for _, id := range p.Modules {
+		expectedModules[id.Name]++
+	}
+	expectedScripts := 0
+	for _, source := range p.Sources {
+		expectedScripts += source.Scripts
+	}
+
+	compiledScripts := 0
+	var unexpected []string
+	for _, item := range items {
+		switch item.CodeType {
+		case CodeTypeModule:
+			if expectedModules[item.Name] == 0 {
+				unexpected = append(unexpected, item.Name)
+				continue
+			}
+			expectedModules[item.Name]--
+		case CodeTypeScript:
+			compiledScripts++
+		default:
+			return fmt.Errorf("compiled item %q: unknown code type %q", item.Name, item.CodeType)
+		}
+	}
+
+	var missing []string
+	for _, id := range p.Modules {
+		if expectedModules[id.Name] > 0 {
+			expectedModules[id.Name]--
+			missing = append(missing, id.Name)
+		}
+	}
+
+	if len(unexpected) > 0 {
+		return fmt.Errorf("compiled modules not found in project sources: %s", strings.Join(unexpected, ", "))
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("project modules not found in compiler output: %s", strings.Join(missing, ", "))
+	}
+	if compiledScripts != expectedScripts {
+		return fmt.Errorf("compiled scripts count mismatch: expected %d, got %d", expectedScripts, compiledScripts)
+	}
+
+	return nil
+}
+
+// SortCompiledItems orders compiled items: modules in the dependency order, then scripts.
+func (p MoveProject) SortCompiledItems(items CompiledItems) CompiledItems {
+	// compiled module item is matched to the project module by name (first unmatched one)
+	orderIdx := func(item CompiledItem, used map[int]bool) int {
+		if item.CodeType == CodeTypeModule {
+			for i, id := range p.Modules {
+				if !used[i] && id.Name == item.Name {
+					used[i] = true
+					return i
+				}
+			}
+		}
+
+		return len(p.Modules)
+	}
+
+	type orderedItem struct {
+		item CompiledItem
+		idx  int
+	}
+	used := make(map[int]bool, len(p.Modules))
+	orderedItems := make([]orderedItem, 0, len(items))
+	for _, item := range items {
+		orderedItems = append(orderedItems, orderedItem{item: item, idx: orderIdx(item, used)})
+	}
+	sort.SliceStable(orderedItems, func(i, j int) bool {
+		return orderedItems[i].idx < orderedItems[j].idx
+	})
+
+	sorted := make(CompiledItems, 0, len(items))
+	for _, orderedItem := range orderedItems {
+		sorted = append(sorted, orderedItem.item)
+	}
+
+	return sorted
+}
+
+// LoadMoveProject loads Move project from a .move file, directory (all .move files recursively) or JSON manifest.
+func LoadMoveProject(path string, sender []byte) (MoveProject, error) {
+	filePaths, err := getProjectFilePaths(path)
+	if err != nil {
+		return MoveProject{}, err
+	}
+	if len(filePaths) == 0 {
+		return MoveProject{}, fmt.Errorf("project %q: no %s files found", path, MoveFileExt)
+	}
+
+	sources := make([]MoveSource, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		text, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return MoveProject{}, fmt.Errorf("project %q: file read: %w", filePath, err)
+		}
+
+		source, err := ParseMoveSource(filePath, string(text), sender)
+		if err != nil {
+			return MoveProject{}, err
+		}
+		sources = append(sources, source)
+	}
+
+	return NewMoveProject(sender, sources)
+}
+
+// NewMoveProject builds the project: orders modules by their dependencies and collects external dependencies.
+func NewMoveProject(sender []byte, sources []MoveSource) (MoveProject, error) {
+	project := MoveProject{Sender: sender}
+
+	// collect declared modules
+	declared := make(map[string]int)
+	declaredIn := make(map[string]string)
+	var modules []MoveModule
+	for _, source := range sources {
+		for _, module := range source.Modules {
+			key := module.String()
+			if prevPath, ok := declaredIn[key]; ok {
+				return MoveProject{}, fmt.Errorf("module %s declared twice: %q, %q", key, prevPath, source.Path)
+			}
+			declared[key], declaredIn[key] = len(modules), source.Path
+			modules = append(modules, module)
+		}
+	}
+
+	// collect external dependencies
+	externalDeps := make(map[string]MoveModuleID)
+	for _, source := range sources {
+		for _, importID := range source.Imports {
+			if _, ok := declared[importID.String()]; !ok {
+				externalDeps[importID.String()] = importID
+			}
+		}
+	}
+
+	// build the modules dependency graph
+	moduleDeps := make([][]int, len(modules))
+	for moduleIdx, module := range modules {
+		for _, importID := range module.Imports {
+			if importIdx, ok := declared[importID.String()]; ok && importIdx != moduleIdx {
+				moduleDeps[moduleIdx] = append(moduleDeps[moduleIdx], importIdx)
+			}
+		}
+	}
+
+	order, err := sortModulesByDependencies(modules, moduleDeps)
+	if err != nil {
+		return MoveProject{}, err
+	}
+	for _, idx := range order {
+		project.Modules = append(project.Modules, modules[idx].MoveModuleID)
+	}
+
+	for _, id := range externalDeps {
+		project.Dependencies = append(project.Dependencies, id)
+	}
+	sort.Slice(project.Dependencies, func(i, j int) bool {
+		return project.Dependencies[i].String() < project.Dependencies[j].String()
+	})
+
+	// order sources by the first declared module position, script only sources go last
+	moduleOrder := make(map[string]int, len(order))
+	for pos, idx := range order {
+		moduleOrder[modules[idx].String()] = pos
+	}
+	sourcePos := func(source MoveSource) int {
+		pos := len(modules)
+		for _, module := range source.Modules {
+			if modulePos := moduleOrder[module.String()]; modulePos < pos {
+				pos = modulePos
+			}
+		}
+		return pos
+	}
+
+	project.Sources = make([]MoveSource, len(sources))
+	copy(project.Sources, sources)
+	sort.SliceStable(project.Sources, func(i, j int) bool {
+		return sourcePos(project.Sources[i]) < sourcePos(project.Sources[j])
+	})
+
+	return project, nil
+}
+
+// ParseMoveSource parses Move source file declarations and imports.
+// Modules declared outside of the address block are bound to the {sender} address.
+func ParseMoveSource(path, text string, sender []byte) (MoveSource, error) {
+	source := MoveSource{Path: path, Text: text}
+
+	text = moveBlockCommentRe.ReplaceAllString(text, "")
+	text = moveLineCommentRe.ReplaceAllString(text, "")
+	text = moveStringRe.ReplaceAllString(text, `""`)
+
+	// address block / module declaration scope
+	type scope struct {
+		address   []byte
+		moduleIdx int
+		depth     int
+	}
+	var scopes []scope
+	curScope := func() scope {
+		if len(scopes) == 0 {
+			return scope{address: sender, moduleIdx: -1}
+		}
+		return scopes[len(scopes)-1]
+	}
+	depth := 0
+	imported := make(map[string]bool)
+
+	for _, match := range moveTokenRe.FindAllStringSubmatch(text, -1) {
+		switch {
+		case match[1] != "":
+			address, err := parseMoveAddress(match[1])
+			if err != nil {
+				return MoveSource{}, fmt.Errorf("file %q: address block: %w", path, err)
+			}
+			scopes = append(scopes, scope{address: address, moduleIdx: -1, depth: depth})
+			depth++
+		case match[2] != "":
+			address := curScope().address
+			scopes = append(scopes, scope{address: address, moduleIdx: len(source.Modules), depth: depth})
+			source.Modules = append(source.Modules, MoveModule{
+				MoveModuleID: MoveModuleID{Address: address, Name: match[2]},
+			})
+			depth++
+		case match[3] != "":
+			source.Scripts++
+			depth++
+		case match[4] != "":
+			// invalid references are skipped (reported by the compiler)
+			address, err := parseMoveAddress(match[4])
+			if err != nil {
+				continue
+			}
+			id := MoveModuleID{Address: address, Name: match[5]}
+			if !imported[id.String()] {
+				imported[id.String()] = true
+				source.Imports = append(source.Imports, id)
+			}
+			if moduleIdx := curScope().moduleIdx; moduleIdx >= 0 {
+				source.Modules[moduleIdx].Imports = append(source.Modules[moduleIdx].Imports, id)
+			}
+		case match[6] == "{":
+			depth++
+		case match[6] == "}":
+			depth--
+			if depth < 0 {
+				return MoveSource{}, fmt.Errorf("file %q: unbalanced braces", path)
+			}
+			if len(scopes) > 0 && scopes[len(scopes)-1].depth == depth {
+				scopes = scopes[:len(scopes)-1]
+			}
+		}
+	}
+
+	if depth != 0 {
+		return MoveSource{}, fmt.Errorf("file %q: unbalanced braces", path)
+	}
+	if len(source.Modules) == 0 && source.Scripts == 0 {
+		return MoveSource{}, fmt.Errorf("file %q: no modules / scripts found", path)
+	}
+
+	return source, nil
+}
+
+// parseMoveAddress converts Move address literal (HEX with 0x prefix / Bech32) to VM address.
+func parseMoveAddress(value string) ([]byte, error) {
+	if strings.HasPrefix(value, "0x") {
+		hexValue := strings.TrimPrefix(value, "0x")
+		if len(hexValue) > 2*common_vm.VMAddressLength {
+			return nil, fmt.Errorf("address %q: too long", value)
+		}
+		if len(hexValue)%2 != 0 {
+			hexValue = "0" + hexValue
+		}
+
+		bz, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, fmt.Errorf("address %q: HEX decode: %w", value, err)
+		}
+
+		address := make([]byte, common_vm.VMAddressLength)
+		copy(address[common_vm.VMAddressLength-len(bz):], bz)
+
+		return address, nil
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(value)
+	if err != nil {
+		return nil, fmt.Errorf("address %q: Bech32 decode: %w", value, err)
+	}
+
+	return common_vm.Bech32ToLibra(accAddress), nil
+}
+
+// sortModulesByDependencies sorts modules topologically keeping the declaration order where possible.
+func sortModulesByDependencies(modules []MoveModule, deps [][]int) ([]int, error) {
+	const (
+		stateNew = iota
+		stateVisiting
+		stateDone
+	)
+
+	states := make([]int, len(modules))
+	order := make([]int, 0, len(modules))
+
+	var visit func(idx int, path []string) error
+	visit = func(idx int, path []string) error {
+		path = append(path, modules[idx].String())
+
+		switch states[idx] {
+		case stateDone:
+			return nil
+		case stateVisiting:
+			return fmt.Errorf("modules cyclic dependency: %s", strings.Join(path, " -> "))
+		}
+
+		states[idx] = stateVisiting
+		for _, depIdx := range deps[idx] {
+			if err := visit(depIdx, path); err != nil {
+				return err
+			}
+		}
+		states[idx] = stateDone
+		order = append(order, idx)
+
+		return nil
+	}
+
+	for idx := range modules {
+		if err := visit(idx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// getProjectFilePaths returns sorted project .move file paths.
+func getProjectFilePaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("project %q: %w", path, err)
+	}
+
+	// directory: manifest or all .move files
+	if info.IsDir() {
+		manifestPath := filepath.Join(path, ProjectManifestName)
+		if _, err := os.Stat(manifestPath); err == nil {
+			return getManifestFilePaths(manifestPath)
+		}
+
+		return getDirFilePaths(path)
+	}
+
+	if filepath.Ext(path) == ".json" {
+		return getManifestFilePaths(path)
+	}
+
+	return []string{path}, nil
+}
+
+// getManifestFilePaths returns sorted .move file paths listed in the project manifest.
+func getManifestFilePaths(manifestPath string) ([]string, error) {
+	bz, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("project manifest %q: file read: %w", manifestPath, err)
+	}
+
+	manifest := MoveProjectManifest{}
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, fmt.Errorf("project manifest %q: JSON unmarshal: %w", manifestPath, err)
+	}
+	if len(manifest.Sources) == 0 {
+		return nil, fmt.Errorf("project manifest %q: sources: empty", manifestPath)
+	}
+
+	baseDir := filepath.Dir(manifestPath)
+	uniquePaths := make(map[string]bool)
+	for _, sourcePath := range manifest.Sources {
+		if !filepath.IsAbs(sourcePath) {
+			sourcePath = filepath.Join(baseDir, sourcePath)
+		}
+
+		info, err := os.Stat(sourcePath)
+		if err != nil {
+			return nil, fmt.Errorf("project manifest %q: source %q: %w", manifestPath, sourcePath, err)
+		}
+
+		if !info.IsDir() {
+			uniquePaths[filepath.Clean(sourcePath)] = true
+			continue
+		}
+
+		dirPaths, err := getDirFilePaths(sourcePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range dirPaths {
+			uniquePaths[p] = true
+		}
+	}
+
+	paths := make([]string, 0, len(uniquePaths))
+	for p := range uniquePaths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// getDirFilePaths returns sorted .move file paths within the directory (recursively).
+func getDirFilePaths(dirPath string) ([]string, error) {
+	var paths []string
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) == MoveFileExt {
+			paths = append(paths, filepath.Clean(path))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("project directory %q: walk: %w", dirPath, err)
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
@@ -0,0 +1,370 @@
+// +build unit
+
+package vm_client
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+func Test_ParseMoveSource(t *testing.T) {
+	sender := common_vm.Bech32ToLibra(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+
+	// address block, sender module, comments and strings
+	{
+		text := `
+address 0x1 {
+module Coins {
+    use 0x1::Vector;
+    // use 0x1::Commented;
+    struct T {}
+}
+}
+
+/* module Commented { use 0x1::Commented; } */
+module Foo {
+    use 0x1::Coins;
+    use 0x01::Account::{Self, T};
+
+    public fun f(): vector<u8> { x"7b7d"; b"{ 0x1::InString }" }
+}
+`
+		source, err := ParseMoveSource("foo.move", text, sender)
+		require.NoError(t, err)
+		require.Equal(t, 0, source.Scripts)
+
+		require.Len(t, source.Modules, 2)
+		require.Equal(t, "0x0000000000000000000000000000000000000001::Coins", source.Modules[0].String())
+		require.Equal(t, "0x"+hex.EncodeToString(sender)+"::Foo", source.Modules[1].String())
+
+		require.Len(t, source.Modules[0].Imports, 1)
+		require.Equal(t, "Vector", source.Modules[0].Imports[0].Name)
+		require.Len(t, source.Modules[1].Imports, 2)
+		require.Equal(t, "Coins", source.Modules[1].Imports[0].Name)
+		require.Equal(t, "Account", source.Modules[1].Imports[1].Name)
+		require.Equal(t, common_vm.StdLibAddress, source.Modules[1].Imports[1].Address)
+
+		require.Len(t, source.Imports, 3)
+	}
+
+	// script
+	{
+		source, err := ParseMoveSource("script.move", "script { use 0x1::Account; fun main() { } }", sender)
+		require.NoError(t, err)
+		require.Equal(t, 1, source.Scripts)
+		require.Empty(t, source.Modules)
+		require.Len(t, source.Imports, 1)
+	}
+
+	// nested comments, nested generics and address-like identifiers
+	{
+		bech32Addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		text := `
+/*
+ * module Commented {
+ *   use 0x1::Commented; // }
+ * }
+ */
+module Foo {
+    use 0x1::Vector; /* use 0x1::Commented; */
+    use ` + bech32Addr + `::Bar;
+
+    struct Wrapper<T> { inner: vector<vector<T>> }
+
+    public fun f(vec1: vector<vector<u8>>): Wrapper<Wrapper<0x1::Coins::T>> {
+        let addr1 = 1; // 0x1::Commented
+        let v = Vector::empty<vector<` + bech32Addr + `::Bar::T>>();
+        vec1::length(&v);
+        abc1qpzry9x::call();
+        addr1
+    }
+}
+`
+		source, err := ParseMoveSource("foo.move", text, sender)
+		require.NoError(t, err)
+
+		require.Len(t, source.Modules, 1)
+		require.Equal(t, "Foo", source.Modules[0].Name)
+
+		importNames := make([]string, 0, len(source.Imports))
+		for _, id := range source.Imports {
+			importNames = append(importNames, id.Name)
+		}
+		require.Equal(t, []string{"Vector", "Bar", "Coins"}, importNames)
+		require.Len(t, source.Modules[0].Imports, 4)
+	}
+
+	// fail: invalid Bech32 address block
+	{
+		_, err := ParseMoveSource("foo.move", "address abc1qpzry9x8gf { module Foo {} }", sender)
+		require.Error(t, err)
+	}
+
+	// fail: unbalanced braces
+	{
+		_, err := ParseMoveSource("foo.move", "module Foo { fun f() { }", sender)
+		require.Error(t, err)
+	}
+
+	// fail: empty
+	{
+		_, err := ParseMoveSource("foo.move", "// module Foo {}", sender)
+		require.Error(t, err)
+	}
+}
+
+func Test_NewMoveProject(t *testing.T) {
+	sender := common_vm.Bech32ToLibra(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	senderAddr := "0x" + hex.EncodeToString(sender)
+
+	parse := func(path, text string) MoveSource {
+		source, err := ParseMoveSource(path, text, sender)
+		require.NoError(t, err)
+		return source
+	}
+
+	// dependency order
+	{
+		sources := []MoveSource{
+			parse("a.move", "module A { use "+senderAddr+"::B; use 0x1::Account; }"),
+			parse("b.move", "module B { use "+senderAddr+"::C; } module D {}"),
+			parse("c.move", "module C { use 0x1::Coins; }"),
+			parse("script.move", "script { use "+senderAddr+"::A; use 0x1::Signer; fun main() {} }"),
+		}
+
+		project, err := NewMoveProject(sender, sources)
+		require.NoError(t, err)
+
+		moduleNames := make([]string, 0, len(project.Modules))
+		for _, id := range project.Modules {
+			moduleNames = append(moduleNames, id.Name)
+		}
+		require.Equal(t, []string{"C", "B", "A", "D"}, moduleNames)
+
+		sourcePaths := make([]string, 0, len(project.Sources))
+		for _, source := range project.Sources {
+			sourcePaths = append(sourcePaths, source.Path)
+		}
+		require.Equal(t, []string{"c.move", "b.move", "a.move", "script.move"}, sourcePaths)
+
+		depNames := make([]string, 0, len(project.Dependencies))
+		for _, id := range project.Dependencies {
+			depNames = append(depNames, id.Name)
+		}
+		require.Equal(t, []string{"Account", "Coins", "Signer"}, depNames)
+
+		// compiled items order
+		items := CompiledItems{
+			{Name: "main", CodeType: CodeTypeScript},
+			{Name: "A", CodeType: CodeTypeModule},
+			{Name: "B", CodeType: CodeTypeModule},
+			{Name: "D", CodeType: CodeTypeModule},
+			{Name: "C", CodeType: CodeTypeModule},
+		}
+		sortedNames := make([]string, 0, len(items))
+		for _, item := range project.SortCompiledItems(items) {
+			sortedNames = append(sortedNames, item.Name)
+		}
+		require.Equal(t, []string{"C", "B", "A", "D", "main"}, sortedNames)
+
+		// compiled items validation
+		require.NoError(t, project.ValidateCompiledItems(items))
+	}
+
+	// fail: compiled items mismatch project declarations
+	{
+		sources := []MoveSource{
+			parse("a.move", "module A {}"),
+			parse("script.move", "script { fun main() {} }"),
+		}
+
+		project, err := NewMoveProject(sender, sources)
+		require.NoError(t, err)
+
+		// ok
+		require.NoError(t, project.ValidateCompiledItems(CompiledItems{
+			{Name: "main", CodeType: CodeTypeScript},
+			{Name: "A", CodeType: CodeTypeModule},
+		}))
+
+		// fail: unexpected module
+		err = project.ValidateCompiledItems(CompiledItems{
+			{Name: "main", CodeType: CodeTypeScript},
+			{Name: "A", CodeType: CodeTypeModule},
+			{Name: "B", CodeType: CodeTypeModule},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "B")
+
+		// fail: missing module
+		err = project.ValidateCompiledItems(CompiledItems{
+			{Name: "main", CodeType: CodeTypeScript},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "A")
+
+		// fail: scripts count
+		err = project.ValidateCompiledItems(CompiledItems{
+			{Name: "A", CodeType: CodeTypeModule},
+		})
+		require.Error(t, err)
+	}
+
+	// fail: cyclic dependency
+	{
+		sources := []MoveSource{
+			parse("a.move", "module A { use "+senderAddr+"::B; }"),
+			parse("b.move", "module B { use "+senderAddr+"::A; }"),
+		}
+
+		_, err := NewMoveProject(sender, sources)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cyclic")
+	}
+
+	// fail: duplicated module
+	{
+		sources := []MoveSource{
+			parse("a.move", "module A {}"),
+			parse("a2.move", "module A {}"),
+		}
+
+		_, err := NewMoveProject(sender, sources)
+		require.Error(t, err)
+	}
+}
+
+func Test_MoveProjectDependencies(t *testing.T) {
+	sender := common_vm.Bech32ToLibra(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+
+	source, err := ParseMoveSource("a.move", "module A { use 0x1::Account; use 0x1::Coins; }", sender)
+	require.NoError(t, err)
+	project, err := NewMoveProject(sender, []MoveSource{source})
+	require.NoError(t, err)
+
+	published := map[string][]byte{
+		"Account": {0x1},
+	}
+	fetcher := func(id MoveModuleID) ([]byte, error) {
+		return published[id.Name], nil
+	}
+
+	// fail: missing dependency
+	{
+		_, err := project.ResolveDependencies(fetcher)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "0x0000000000000000000000000000000000000001::Coins")
+	}
+
+	// ok
+	{
+		published["Coins"] = []byte{0x2}
+		codes, err := project.ResolveDependencies(fetcher)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{{0x1}, {0x2}}, codes)
+
+		// project hash depends on dependencies bytecode
+		hash1 := project.Hash(codes)
+		require.Equal(t, hash1, project.Hash(codes))
+		require.NotEqual(t, hash1, project.Hash([][]byte{{0x1}, {0x3}}))
+	}
+}
+
+func Test_LoadMoveProject(t *testing.T) {
+	sender := common_vm.Bech32ToLibra(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+
+	dir, err := ioutil.TempDir("", "move_project")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile := func(path, content string) {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+	writeFile("modules/a.move", "module A { use 0x1::Account; }")
+	writeFile("modules/nested/b.move", "module B {}")
+	writeFile("scripts/script.move", "script { fun main() {} }")
+	writeFile("readme.md", "module C {}")
+
+	// directory
+	{
+		project, err := LoadMoveProject(dir, sender)
+		require.NoError(t, err)
+		require.Len(t, project.Sources, 3)
+		require.Len(t, project.Modules, 2)
+		require.Len(t, project.Dependencies, 1)
+	}
+
+	// manifest
+	{
+		writeFile("custom.json", `{"name": "test", "sources": ["modules/nested", "scripts/script.move"]}`)
+
+		project, err := LoadMoveProject(filepath.Join(dir, "custom.json"), sender)
+		require.NoError(t, err)
+		require.Len(t, project.Sources, 2)
+		require.Len(t, project.Modules, 1)
+		require.Equal(t, "B", project.Modules[0].Name)
+	}
+
+	// directory with manifest
+	{
+		writeFile(ProjectManifestName, `{"name": "test", "sources": ["modules/a.move"]}`)
+
+		project, err := LoadMoveProject(dir, sender)
+		require.NoError(t, err)
+		require.Len(t, project.Sources, 1)
+		require.Equal(t, "A", project.Modules[0].Name)
+	}
+
+	// single file
+	{
+		project, err := LoadMoveProject(filepath.Join(dir, "scripts/script.move"), sender)
+		require.NoError(t, err)
+		require.Len(t, project.Sources, 1)
+		require.Empty(t, project.Modules)
+	}
+
+	// fail: not found
+	{
+		_, err := LoadMoveProject(filepath.Join(dir, "not_existing"), sender)
+		require.Error(t, err)
+	}
+}
+
+func Test_CompileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compile_cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := NewCompileCache(dir)
+
+	// not found
+	{
+		_, found, err := cache.Get("key")
+		require.NoError(t, err)
+		require.False(t, found)
+	}
+
+	// set / get
+	{
+		items := CompiledItems{
+			{Code: "0102", ByteCode: []byte{0x1, 0x2}, Name: "A", CodeType: CodeTypeModule},
+		}
+		require.NoError(t, cache.Set("key", items))
+
+		cachedItems, found, err := cache.Get("key")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, items, cachedItems)
+	}
+}
@@ -1,5 +1,10 @@
 package vm_client
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	CodeTypeModule = "module"
 	CodeTypeScript = "script"
@@ -38,3 +43,54 @@ type ModuleMethod struct {
 	Arguments      []string `json:"arguments"`
 	Returns        []string `json:"returns"`
 }
+
+// TxWriteSetView is a transaction writeSets summary with decoded values.
+type TxWriteSetView struct {
+	TxHash string               `json:"tx_hash"`
+	Height int64                `json:"height"`
+	Items  []TxWriteSetViewItem `json:"items"`
+}
+
+func (v TxWriteSetView) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString(fmt.Sprintf("TxWriteSet:\n"+
+		"  TxHash: %s\n"+
+		"  Height: %d\n",
+		v.TxHash, v.Height,
+	))
+	for i, item := range v.Items {
+		strBuilder.WriteString(item.String())
+		if i < len(v.Items)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// TxWriteSetViewItem is a writeSet operation with decoded (or HEX) value.
+type TxWriteSetViewItem struct {
+	Op        string `json:"op"`
+	Address   string `json:"address"`
+	Path      string `json:"path"`
+	ValueHash string `json:"value_hash,omitempty"`
+	// HEX value (if not decoded)
+	Value string `json:"value,omitempty"`
+	// Decoded value for known resource types
+	Decoded string `json:"decoded,omitempty"`
+	// Value read note (value was changed later / not available)
+	Note string `json:"note,omitempty"`
+}
+
+func (item TxWriteSetViewItem) String() string {
+	return fmt.Sprintf("WriteSetItem:\n"+
+		"  Op: %s\n"+
+		"  Address: %s\n"+
+		"  Path: %s\n"+
+		"  ValueHash: %s\n"+
+		"  Value: %s\n"+
+		"  Decoded: %s\n"+
+		"  Note: %s",
+		item.Op, item.Address, item.Path, item.ValueHash, item.Value, item.Decoded, item.Note,
+	)
+}
@@ -0,0 +1,30 @@
+package vm_client
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// WriteSetValueDecoder decodes known VM resource value to a human readable string.
+// {ok} is false if resource is not recognized by the decoder.
+type WriteSetValueDecoder func(cliCtx context.CLIContext, address, path, value []byte) (decoded string, ok bool)
+
+var (
+	// Registered writeSet value decoders (used by the tx-writeset query)
+	writeSetValueDecoders []WriteSetValueDecoder
+)
+
+// RegisterWriteSetValueDecoder adds a new writeSet value decoder.
+func RegisterWriteSetValueDecoder(decoder WriteSetValueDecoder) {
+	writeSetValueDecoders = append(writeSetValueDecoders, decoder)
+}
+
+// DecodeWriteSetValue decodes writeSet value using registered decoders.
+func DecodeWriteSetValue(cliCtx context.CLIContext, address, path, value []byte) (string, bool) {
+	for _, decoder := range writeSetValueDecoders {
+		if decoded, ok := decoder(cliCtx, address, path, value); ok {
+			return decoded, true
+		}
+	}
+
+	return "", false
+}
@@ -20,6 +20,10 @@ func NewGovHandler(k Keeper) gov.Handler {
 		switch p := c.(type) {
 		case StdlibUpdateProposal:
 			return handleUpdateStdlibProposalDryRun(ctx, k, p)
+		case ModulePublishProposal:
+			return handleModulePublishProposalDryRun(ctx, k, p)
+		case CancelScheduledProposal:
+			return handleCancelScheduledProposal(ctx, k, p)
 		default:
 			return fmt.Errorf("unsupported proposal content type %q for module %q", c.ProposalType(), ModuleName)
 		}
@@ -49,9 +53,59 @@ func handleUpdateStdlibProposalDryRun(ctx sdk.Context, k Keeper, proposal Stdlib
 	return nil
 }
 
+// handleModulePublishProposalDryRun handles DVM modules publish proposal: DVM validation and scheduling.
+func handleModulePublishProposalDryRun(ctx sdk.Context, k Keeper, proposal ModulePublishProposal) error {
+	logger := k.GetLogger(ctx)
+
+	// DVM check (dry-run deploy)
+	msg, err := getModulePublishMsg(proposal)
+	if err != nil {
+		return err
+	}
+	if err := k.DeployContractDryRun(ctx, msg); err != nil {
+		return fmt.Errorf("contract dry run deploy failed: %w", err)
+	}
+
+	// add proposal to queue
+	if err := k.ScheduleProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("proposal scheduled:\n%s", proposal.String()))
+
+	return nil
+}
+
+// handleCancelScheduledProposal handles scheduled proposal cancel proposal: removes proposal from the queue.
+func handleCancelScheduledProposal(ctx sdk.Context, k Keeper, proposal CancelScheduledProposal) error {
+	if err := k.CancelScheduledProposal(ctx, proposal.ProposalID); err != nil {
+		return err
+	}
+
+	k.GetLogger(ctx).Info(fmt.Sprintf("scheduled proposal %d canceled: %s", proposal.ProposalID, proposal.Reason))
+
+	return nil
+}
+
 // getStdlibUpdateMsg returns deploy message for stdlib update.
 func getStdlibUpdateMsg(proposal StdlibUpdateProposal) (MsgDeployModule, error) {
 	msg := NewMsgDeployModule(common_vm.StdLibAddress, []Contract{proposal.Code})
+	if len(proposal.Url) <= ModuleSourceMaxLength {
+		msg.Source = proposal.Url
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return MsgDeployModule{}, fmt.Errorf("deploy message validation failed: %w", err)
+	}
+
+	return msg, nil
+}
+
+// getModulePublishMsg returns deploy message for modules publish.
+func getModulePublishMsg(proposal ModulePublishProposal) (MsgDeployModule, error) {
+	msg := NewMsgDeployModule(proposal.Address, proposal.Code)
+	if len(proposal.Url) <= ModuleSourceMaxLength {
+		msg.Source = proposal.Url
+	}
 	if err := msg.ValidateBasic(); err != nil {
 		return MsgDeployModule{}, fmt.Errorf("deploy message validation failed: %w", err)
 	}
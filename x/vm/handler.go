@@ -8,10 +8,12 @@ import (
 // NewHandler creates sdk.Msg type messages handler.
 func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		// setup current (actual) DS context (that is also done in the BeginBlock
-		// TODO: move it to base app and set before transaction execution maybe? or find way to have actual context always
+		// DS contexts are registered per VM request (DS default context is set in the BeginBlock)
+		// msg handlers are called in the CheckTx mode only for simulation
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
-		k.SetDSContext(ctx)
+		if ctx.IsCheckTx() {
+			ctx = WithDSContextKind(ctx, DSContextSimulate)
+		}
 
 		switch msg := msg.(type) {
 		case MsgDeployModule:
@@ -4,8 +4,10 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"flag"
+	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"net"
@@ -31,7 +33,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
 
 	vmConfig "github.com/dfinance/dnode/cmd/config"
 	"github.com/dfinance/dnode/helpers"
@@ -99,12 +103,15 @@ var (
 
 type vmServer struct{}
 
-func (server vmServer) PublishModule(context.Context, *vm_grpc.VMPublishModule) (*vm_grpc.VMExecuteResponse, error) {
+func (server vmServer) PublishModule(_ context.Context, req *vm_grpc.VMPublishModule) (*vm_grpc.VMExecuteResponse, error) {
 	values := make([]*vm_grpc.VMValue, 1)
 	values[0] = &vm_grpc.VMValue{
 		Type:  vm_grpc.VmWriteOp_Value,
 		Value: randomValue(512),
-		Path:  randomPath(),
+		Path: &vm_grpc.VMAccessPath{
+			Address: req.Sender,
+			Path:    append([]byte{glav.ModuleTag}, randomValue(32)...),
+		},
 	}
 
 	return &vm_grpc.VMExecuteResponse{
@@ -115,6 +122,24 @@ func (server vmServer) PublishModule(context.Context, *vm_grpc.VMPublishModule)
 	}, nil
 }
 
+func (server vmServer) GetMetadata(_ context.Context, req *metadata_grpc.Bytecode) (*metadata_grpc.Metadata, error) {
+	codeHash := sha256.Sum256(req.Code)
+
+	return &metadata_grpc.Metadata{
+		Meta: &metadata_grpc.Metadata_Module{
+			Module: &metadata_grpc.ModuleMeta{
+				Name: fmt.Sprintf("Module_%x", codeHash[:4]),
+				Types: []*metadata_grpc.Struct{
+					{Name: "T", IsResource: true, Field: []*metadata_grpc.Field{{Name: "value", Type: "u64"}}},
+				},
+				Functions: []*metadata_grpc.Function{
+					{Name: "get", IsPublic: true, Arguments: []string{"address"}, Returns: []string{"u64"}},
+				},
+			},
+		},
+	}, nil
+}
+
 func (server vmServer) ExecuteScript(context.Context, *vm_grpc.VMExecuteScript) (*vm_grpc.VMExecuteResponse, error) {
 	values := make([]*vm_grpc.VMValue, 2)
 	values[0] = &vm_grpc.VMValue{
@@ -165,6 +190,7 @@ type testInput struct {
 	keyParams  *sdk.KVStoreKey
 	tkeyParams *sdk.TransientStoreKey
 	keyVM      *sdk.KVStoreKey
+	tkeyVM     *sdk.TransientStoreKey
 
 	pathBytes    []byte
 	codeBytes    []byte
@@ -205,6 +231,7 @@ func newTestInput(launchMock bool) testInput {
 		keyCCS:     sdk.NewKVStoreKey(ccstorage.StoreKey),
 		tkeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
 		keyVM:      sdk.NewKVStoreKey(types.StoreKey),
+		tkeyVM:     sdk.NewTransientStoreKey(types.TStoreKey),
 	}
 
 	types.RegisterCodec(input.cdc)
@@ -222,6 +249,7 @@ func newTestInput(launchMock bool) testInput {
 	mstore.MountStoreWithDB(input.keyCCS, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.tkeyParams, sdk.StoreTypeTransient, db)
 	mstore.MountStoreWithDB(input.keyVM, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.tkeyVM, sdk.StoreTypeTransient, db)
 	err := mstore.LoadLatestVersion()
 	if err != nil {
 		panic(err)
@@ -281,9 +309,18 @@ func newTestInput(launchMock bool) testInput {
 	input.vk = NewKeeper(
 		input.cdc,
 		input.keyVM,
+		input.tkeyVM,
+		input.pk.Subspace(types.DefaultParamspace),
 		clientConn,
 		listener,
 		config,
+		[]Option{
+			WithDSDataMiddlewares(
+				oracle.NewPriceInfoDSMiddleware(&input.ok),
+				ccstorage.NewCurrencyInfoDSMiddleware(&input.cs),
+				ccstorage.NewFrozenInfoDSMiddleware(&input.cs),
+			),
+		},
 		ccstorage.RequestVMStoragePerms(),
 		oracle.RequestVMStoragePerms(),
 	)
@@ -344,6 +381,7 @@ func newMockVMConfig() *vmConfig.VMConfig {
 		DataListen:     *dataListenMock,
 		MaxAttempts:    vmConfig.DefaultMaxAttempts,
 		ReqTimeoutInMs: vmConfig.DefaultReqTimeout,
+		DSCacheSize:    vmConfig.DefaultDSCacheSize,
 	}
 }
 
@@ -386,6 +424,7 @@ func startMockDSServer() (*vmServer, *grpc.Server, *bufconn.Listener) {
 	server := grpc.NewServer()
 	vm_grpc.RegisterVMModulePublisherServer(server, &vmServer)
 	vm_grpc.RegisterVMScriptExecutorServer(server, &vmServer)
+	metadata_grpc.RegisterDVMBytecodeMetadataServer(server, &vmServer)
 
 	go func() {
 		if err := server.Serve(vmListener); err != nil {
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// DS cache Prometheus metrics (registered once as multiple DS servers might exist within a process)
+	dsCacheHitsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "dnode",
+		Subsystem: "vm_ds",
+		Name:      "cache_hits",
+		Help:      "Number of DS server read cache hits.",
+	})
+	dsCacheMissesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "dnode",
+		Subsystem: "vm_ds",
+		Name:      "cache_misses",
+		Help:      "Number of DS server read cache misses.",
+	})
+	dsCacheMetricsOnce sync.Once
+)
+
+// DSCacheMetrics contains DS server read cache stats.
+type DSCacheMetrics struct {
+	// Enabled flag
+	Enabled bool
+	// Number of cached entries
+	Size int
+	// Number of cache hits
+	Hits uint64
+	// Number of cache misses
+	Misses uint64
+}
+
+// dsCacheEntry is a cached storage read result.
+type dsCacheEntry struct {
+	value []byte
+	found bool
+}
+
+// dsCache is a DS server LRU read cache: storage key -> value.
+// Cache is populated from the DS default (BeginBlock) context and is reset per block.
+// Written keys are not cached till the next reset, as writes might be applied to a context different from the default one
+// (failed txs writes are tracked as well).
+type dsCache struct {
+	sync.Mutex
+	lru    *lru.Cache
+	dirty  map[string]bool
+	hits   uint64
+	misses uint64
+}
+
+// Enabled checks if cache is enabled.
+func (c *dsCache) Enabled() bool {
+	return c.lru != nil
+}
+
+// IsDirty checks if key was written since the last reset.
+func (c *dsCache) IsDirty(key []byte) bool {
+	if c.lru == nil {
+		return false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	return c.dirty[string(key)]
+}
+
+// Get returns cached storage read result (ok is false on miss).
+func (c *dsCache) Get(key []byte) (value []byte, found, ok bool) {
+	if c.lru == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.dirty[string(key)] {
+		if rawEntry, exists := c.lru.Get(string(key)); exists {
+			entry := rawEntry.(dsCacheEntry)
+			c.hits++
+			dsCacheHitsCounter.Inc()
+
+			return entry.value, entry.found, true
+		}
+	}
+	c.misses++
+	dsCacheMissesCounter.Inc()
+
+	return
+}
+
+// Add caches storage read result (skipped for written keys).
+func (c *dsCache) Add(key, value []byte, found bool) {
+	if c.lru == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.dirty[string(key)] {
+		return
+	}
+	c.lru.Add(string(key), dsCacheEntry{value: value, found: found})
+}
+
+// Invalidate removes key from the cache and marks it as written.
+func (c *dsCache) Invalidate(key []byte) {
+	if c.lru == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.lru.Remove(string(key))
+	c.dirty[string(key)] = true
+}
+
+// Reset drops all cached entries.
+func (c *dsCache) Reset() {
+	if c.lru == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.lru.Purge()
+	c.dirty = make(map[string]bool)
+}
+
+// Metrics returns cache stats.
+func (c *dsCache) Metrics() DSCacheMetrics {
+	if c.lru == nil {
+		return DSCacheMetrics{}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	return DSCacheMetrics{
+		Enabled: true,
+		Size:    c.lru.Len(),
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+}
+
+// newDSCache creates a new DS read cache (disabled if {size} is 0).
+func newDSCache(size uint) *dsCache {
+	c := &dsCache{
+		dirty: make(map[string]bool),
+	}
+	if size == 0 {
+		return c
+	}
+
+	cache, err := lru.New(int(size))
+	if err != nil {
+		panic(err)
+	}
+	c.lru = cache
+
+	dsCacheMetricsOnce.Do(func() {
+		prometheus.MustRegister(dsCacheHitsCounter, dsCacheMissesCounter)
+	})
+
+	return c
+}
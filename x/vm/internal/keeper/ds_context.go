@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+const (
+	// gRPC metadata key used to pass DS context ID with VM requests (DVM returns it back with DS requests)
+	DSContextIDMetadataKey = "x-ds-context-id"
+)
+
+// DSContextKind defines DVM execution type (DS server context type).
+type DSContextKind string
+
+const (
+	DSContextDeliver  DSContextKind = "deliver"
+	DSContextCheck    DSContextKind = "check"
+	DSContextQuery    DSContextKind = "query"
+	DSContextSimulate DSContextKind = "simulate"
+)
+
+// dsContextKindKey is a sdk.Context value key for DSContextKind.
+type dsContextKindKey struct{}
+
+// WithDSContextKind marks context with DS context kind.
+func WithDSContextKind(ctx sdk.Context, kind DSContextKind) sdk.Context {
+	return ctx.WithValue(dsContextKindKey{}, kind)
+}
+
+// GetDSContextKind returns context DS context kind (explicit mark or check / deliver based on the context).
+func GetDSContextKind(ctx sdk.Context) DSContextKind {
+	if kind, ok := getExplicitDSContextKind(ctx); ok {
+		return kind
+	}
+
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return DSContextCheck
+	}
+
+	return DSContextDeliver
+}
+
+// getExplicitDSContextKind returns DS context kind if context was marked.
+func getExplicitDSContextKind(ctx sdk.Context) (DSContextKind, bool) {
+	kind, ok := ctx.Value(dsContextKindKey{}).(DSContextKind)
+
+	return kind, ok
+}
+
+// RegisterContext registers a read-only context view for a single VM request.
+// Returned ID should be passed to DVM via {DSContextIDMetadataKey} gRPC metadata, release func must be called after the request.
+// Registration never waits for other VM requests: requests of different kinds run concurrently.
+func (server *DSServer) RegisterContext(ctx sdk.Context) (id string, release func()) {
+	kind := GetDSContextKind(ctx)
+	id = fmt.Sprintf("%s-%d", kind, atomic.AddUint64(&server.ctxIDCounter, 1))
+
+	// read-only view: writes (if any) go to a cache, gas is not consumed
+	view := ctx.
+		WithMultiStore(ctx.MultiStore().CacheMultiStore()).
+		WithGasMeter(types.NewDumbGasMeter())
+
+	server.Lock()
+	server.contexts[id] = dsContext{ctx: view, kind: kind}
+	if kind == DSContextDeliver {
+		server.deliverCtxID = id
+	}
+	server.Unlock()
+
+	release = func() {
+		server.Lock()
+		delete(server.contexts, id)
+		if server.deliverCtxID == id {
+			server.deliverCtxID = ""
+		}
+		server.Unlock()
+	}
+
+	return
+}
+
+// resolveContext returns DS context for gRPC request using context ID metadata.
+// If ID is not provided (DVM doesn't pass it through), in progress deliver request context is used (consensus requests
+// are never served with a non-deliver context), then the only in progress VM request context, then the default one.
+// Contract: caller must hold the server lock.
+func (server *DSServer) resolveContext(grpcCtx context.Context) dsContext {
+	if grpcCtx != nil {
+		if md, ok := metadata.FromIncomingContext(grpcCtx); ok {
+			if ids := md.Get(DSContextIDMetadataKey); len(ids) > 0 {
+				if dsCtx, found := server.contexts[ids[0]]; found {
+					return dsCtx
+				}
+				server.GetLogger().Error(fmt.Sprintf("DS context %q: not found, using the default one", ids[0]))
+
+				return server.defaultContext()
+			}
+		}
+	}
+
+	if server.deliverCtxID != "" {
+		return server.contexts[server.deliverCtxID]
+	}
+
+	if len(server.contexts) == 1 {
+		for _, dsCtx := range server.contexts {
+			return dsCtx
+		}
+	}
+
+	return server.defaultContext()
+}
+
+// defaultContext returns DS default context.
+// Contract: caller must hold the server lock.
+func (server *DSServer) defaultContext() dsContext {
+	return dsContext{ctx: server.ctx, kind: DSContextDeliver, isDefault: true}
+}
+
+// dsContext is a DS server storage context.
+type dsContext struct {
+	ctx       sdk.Context
+	kind      DSContextKind
+	isDefault bool
+}
+
+// useCache checks if read cache can be used for the context.
+// Cache is valid for deliver contexts only: keys unchanged since the block start have the default context values.
+func (c dsContext) useCache() bool {
+	return c.isDefault || c.kind == DSContextDeliver
+}
+
+// withDSContextID adds DS context ID to the outgoing gRPC request.
+func withDSContextID(grpcCtx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(grpcCtx, DSContextIDMetadataKey, id)
+}
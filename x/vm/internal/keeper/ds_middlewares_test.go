@@ -0,0 +1,116 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/oracle"
+	"github.com/dfinance/dnode/x/vm/internal/middlewares"
+)
+
+// Test chain data exposed to Move via DS data middlewares (default and registered by NewKeeper options).
+func TestVMKeeper_DSDataMiddlewares(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	proposerAddr := secp256k1.GenPrivKey().PubKey().Address()
+	header := input.ctx.BlockHeader()
+	header.ProposerAddress = proposerAddr
+	ctx := input.ctx.WithBlockHeader(header)
+
+	// chain info
+	{
+		bz := input.vk.GetValueWithMiddlewares(ctx, &vm_grpc.VMAccessPath{
+			Address: common_vm.StdLibAddress,
+			Path:    middlewares.ChainInfoPath(),
+		})
+		require.NotNil(t, bz)
+
+		var chainInfo middlewares.ChainInfo
+		require.NoError(t, lcs.Unmarshal(bz, &chainInfo))
+		require.Equal(t, ctx.ChainID(), string(chainInfo.ChainID))
+		require.EqualValues(t, proposerAddr.Bytes(), chainInfo.Proposer)
+	}
+
+	// currency info: computed on the fly, equals to the stored one
+	{
+		path := &vm_grpc.VMAccessPath{
+			Address: common_vm.StdLibAddress,
+			Path:    glav.CurrencyInfoVector("xfi"),
+		}
+		bz := input.vk.GetValueWithMiddlewares(ctx, path)
+		require.NotNil(t, bz)
+		require.Equal(t, input.vk.GetValue(ctx, path), bz)
+	}
+
+	// oracle price info
+	{
+		oracleAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		assetCode := dnTypes.AssetCode("eth_usdt")
+		input.ok.SetParams(ctx, oracle.Params{
+			Assets: oracle.Assets{
+				oracle.NewAsset(assetCode, oracle.Oracles{oracle.Oracle{Address: oracleAddr}}, true),
+			},
+			Nominees: []string{oracleAddr.String()},
+			PostPrice: oracle.PostPriceParams{
+				ReceivedAtDiffInS: 3600,
+			},
+		})
+
+		// no price yet
+		path, err := oracle.GetAssetCodePriceInfoPath(assetCode)
+		require.NoError(t, err)
+		require.Nil(t, input.vk.GetValueWithMiddlewares(ctx, path))
+
+		receivedAt := time.Unix(1000, 0)
+		_, err = input.ok.SetPrice(ctx, oracleAddr, assetCode, sdk.NewInt(200000000), sdk.NewInt(100000000), receivedAt)
+		require.NoError(t, err)
+		require.NoError(t, input.ok.SetCurrentPrices(ctx))
+
+		// direct asset
+		bz := input.vk.GetValueWithMiddlewares(ctx, path)
+		require.NotNil(t, bz)
+
+		var priceInfo oracle.ResPriceInfo
+		require.NoError(t, lcs.Unmarshal(bz, &priceInfo))
+		require.Equal(t, "200000000", priceInfo.AskPrice.String())
+		require.Equal(t, "100000000", priceInfo.BidPrice.String())
+		require.EqualValues(t, receivedAt.Unix(), priceInfo.ReceivedAt)
+
+		// reversed asset
+		reversedPath, err := oracle.GetAssetCodePriceInfoPath(assetCode.ReverseCode())
+		require.NoError(t, err)
+		bz = input.vk.GetValueWithMiddlewares(ctx, reversedPath)
+		require.NotNil(t, bz)
+
+		require.NoError(t, lcs.Unmarshal(bz, &priceInfo))
+		require.Equal(t, "100000000", priceInfo.AskPrice.String())
+		require.Equal(t, "50000000", priceInfo.BidPrice.String())
+
+		// price info path differs from the stored price path
+		storedPath, err := oracle.GetAssetCodePath(assetCode)
+		require.NoError(t, err)
+		require.NotEqual(t, storedPath.Path, path.Path)
+	}
+
+	// non-middleware path
+	{
+		require.Nil(t, input.vk.GetValueWithMiddlewares(ctx, &vm_grpc.VMAccessPath{
+			Address: common_vm.StdLibAddress,
+			Path:    glav.CurrencyInfoVector("unknown"),
+		}))
+	}
+}
@@ -6,13 +6,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/dfinance/dvm-proto/go/ds_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
@@ -21,20 +22,32 @@ import (
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
+const (
+	// MultiGetRaw gRPC trailer key listing request path indexes with no data (comma separated)
+	DSNoDataTrailerKey = "ds-no-data-idxs"
+	// MultiGetRaw gRPC trailer key with error code for {DSNoDataTrailerKey} indexes
+	DSErrorCodeTrailerKey = "ds-error-code"
+)
+
 // Check DSServer implements gRPC service.
 var _ ds_grpc.DSServiceServer = &DSServer{}
 
 // DSServer is a DataSource server that catches VM client data requests.
 type DSServer struct {
 	ds_grpc.UnimplementedDSServiceServer
-	sync.Mutex
+	sync.RWMutex
 	//
 	isStarted bool // check if server already listens
 	//
-	keeper *Keeper
-	ctx    sdk.Context // current storage context
+	keeper       *Keeper
+	ctx          sdk.Context          // default storage context (latest deliver context)
+	contexts     map[string]dsContext // in progress VM requests contexts (key: context ID)
+	deliverCtxID string               // in progress deliver VM request context ID (empty if none)
+	ctxIDCounter uint64               // context IDs generator
 	//
 	dataMiddlewares []common_vm.DSDataMiddleware // data middleware handlers
+	//
+	cache *dsCache // storage read cache (populated from the default context, reset per block)
 }
 
 // GetLogger gets logger with DS server context.
@@ -52,16 +65,32 @@ func (server *DSServer) RegisterDataMiddleware(md common_vm.DSDataMiddleware) {
 	server.dataMiddlewares = append(server.dataMiddlewares, md)
 }
 
-// SetContext updates server storage context.
+// SetContext updates server default storage context and resets the read cache (should be called once per block).
 func (server *DSServer) SetContext(ctx sdk.Context) {
 	server.Lock()
 	defer server.Unlock()
 
 	server.ctx = ctx
+	server.cache.Reset()
+}
+
+// GetCacheMetrics returns read cache stats.
+func (server *DSServer) GetCacheMetrics() DSCacheMetrics {
+	return server.cache.Metrics()
+}
+
+// invalidateCache removes storage key from the read cache (key write notification).
+func (server *DSServer) invalidateCache(key []byte) {
+	server.cache.Invalidate(key)
 }
 
 // GetRaw implements gRPC service handler: returns value from the storage.
-func (server *DSServer) GetRaw(_ context.Context, req *ds_grpc.DSAccessPath) (*ds_grpc.DSRawResponse, error) {
+func (server *DSServer) GetRaw(ctx context.Context, req *ds_grpc.DSAccessPath) (*ds_grpc.DSRawResponse, error) {
+	server.RLock()
+	defer server.RUnlock()
+
+	dsCtx := server.resolveContext(ctx)
+
 	path := &vm_grpc.VMAccessPath{
 		Address: req.Address,
 		Path:    req.Path,
@@ -69,59 +98,122 @@ func (server *DSServer) GetRaw(_ context.Context, req *ds_grpc.DSAccessPath) (*d
 
 	server.GetLogger().Info(fmt.Sprintf("Get path: %s", types.StringifyVMPath(path)))
 
+	blob, found := server.getRaw(dsCtx, path)
+	if !found {
+		return ErrNoData(req), nil
+	}
+
+	return &ds_grpc.DSRawResponse{Blob: blob}, nil
+}
+
+// MultiGetRaw implements gRPC service handler: returns multiple values from the storage.
+// Response blobs order matches request paths order.
+// As DSRawResponses has no per-path error fields, missing path has an empty blob and its index
+// is reported with NO_DATA code via the {DSNoDataTrailerKey} gRPC trailer.
+func (server *DSServer) MultiGetRaw(ctx context.Context, req *ds_grpc.DSAccessPaths) (*ds_grpc.DSRawResponses, error) {
+	server.RLock()
+	defer server.RUnlock()
+
+	dsCtx := server.resolveContext(ctx)
+
+	server.GetLogger().Info(fmt.Sprintf("MultiGet paths: %d", len(req.Paths)))
+
+	resp := &ds_grpc.DSRawResponses{
+		Blobs: make([][]byte, 0, len(req.Paths)),
+	}
+	noDataIdxs := make([]string, 0)
+
+	for i, dsPath := range req.Paths {
+		if dsPath == nil {
+			resp.Blobs = append(resp.Blobs, []byte{})
+			noDataIdxs = append(noDataIdxs, strconv.Itoa(i))
+			continue
+		}
+
+		path := &vm_grpc.VMAccessPath{
+			Address: dsPath.Address,
+			Path:    dsPath.Path,
+		}
+
+		blob, found := server.getRaw(dsCtx, path)
+		if !found {
+			resp.Blobs = append(resp.Blobs, []byte{})
+			noDataIdxs = append(noDataIdxs, strconv.Itoa(i))
+			continue
+		}
+		resp.Blobs = append(resp.Blobs, blob)
+	}
+
+	if len(noDataIdxs) > 0 {
+		trailer := metadata.Pairs(
+			DSNoDataTrailerKey, strings.Join(noDataIdxs, ","),
+			DSErrorCodeTrailerKey, ds_grpc.DSRawResponse_NO_DATA.String(),
+		)
+		if err := grpc.SetTrailer(ctx, trailer); err != nil {
+			server.GetLogger().Debug(fmt.Sprintf("Setting MultiGet trailer: %v", err))
+		}
+	}
+
+	return resp, nil
+}
+
+// getRaw returns value for path using data middlewares and the storage.
+// Contract: caller must hold the server lock.
+func (server *DSServer) getRaw(dsCtx dsContext, path *vm_grpc.VMAccessPath) (blob []byte, found bool) {
 	// here go with middlewares
-	blob, err := server.processMiddlewares(path)
+	blob, err := server.processMiddlewares(dsCtx.ctx, path)
 	if err != nil {
 		server.GetLogger().Error(fmt.Sprintf("Error processing middlewares for path %s: %v", types.StringifyVMPath(path), err))
-		return ErrNoData(req), nil
+		return nil, false
 	}
 
 	if blob != nil {
-		return &ds_grpc.DSRawResponse{Blob: blob}, nil
+		return blob, true
 	}
 
-	// we can move it to middleware later.
-	if !server.keeper.hasValue(server.ctx, path) {
-		server.GetLogger().Debug(fmt.Sprintf("Can't find path: %s", types.StringifyVMPath(path)))
-		return ErrNoData(req), nil
+	// storage read (middleware results are context-dependant and not cached)
+	key := common_vm.GetPathKey(path)
+	if !dsCtx.useCache() || !server.cache.Enabled() {
+		return server.readValue(dsCtx.ctx, path)
 	}
 
-	server.GetLogger().Debug(fmt.Sprintf("Get path: %s", types.StringifyVMPath(path)))
-	blob = server.keeper.getValue(server.ctx, path)
-	server.GetLogger().Debug(fmt.Sprintf("Return values: %s\n", hex.EncodeToString(blob)))
-
-	return &ds_grpc.DSRawResponse{Blob: blob}, nil
-}
+	if cachedBlob, cachedFound, ok := server.cache.Get(key); ok {
+		server.GetLogger().Debug(fmt.Sprintf("Cached path: %s (found: %t)", types.StringifyVMPath(path), cachedFound))
+		return cachedBlob, cachedFound
+	}
 
-// MultiGetRaw implements gRPC service handler: returns multiple values from the storage.
-func (server *DSServer) MultiGetRaw(_ context.Context, req *ds_grpc.DSAccessPaths) (*ds_grpc.DSRawResponses, error) {
-	/*resps := &ds_grpc.DSRawResponses{
-		Blobs: make([][]byte, 0),
+	// key was written since the block start: default and request context values might differ
+	if server.cache.IsDirty(key) {
+		return server.readValue(dsCtx.ctx, path)
 	}
 
-	for _, dsAccessPath := range req.Paths {
-		path := &vm_grpc.VMAccessPath{
-			Address: dsAccessPath.Address,
-			Path:    dsAccessPath.Path,
-		}
+	// key wasn't written since the block start: the default context value is the same for all deliver contexts
+	blob, found = server.readValue(server.ctx, path)
+	server.cache.Add(key, blob, found)
 
-		if !server.keeper.hasValue(*server.ctx, path) {
-			return nil, types.ErrDSMissedValue(*path)
-		}
+	return blob, found
+}
 
-		blob := server.keeper.getValue(*server.ctx, path)
-		resps.Blobs = append(resps.Blobs, blob)
+// readValue reads value for path from the storage.
+func (server *DSServer) readValue(ctx sdk.Context, path *vm_grpc.VMAccessPath) (blob []byte, found bool) {
+	// we can move it to middleware later.
+	if !server.keeper.hasValue(ctx, path) {
+		server.GetLogger().Debug(fmt.Sprintf("Can't find path: %s", types.StringifyVMPath(path)))
+		return nil, false
 	}
 
-	return resps, nil*/
-	return nil, status.Errorf(codes.Unimplemented, "MultiGetRaw unimplemented")
+	server.GetLogger().Debug(fmt.Sprintf("Get path: %s", types.StringifyVMPath(path)))
+	blob = server.keeper.getValue(ctx, path)
+	server.GetLogger().Debug(fmt.Sprintf("Return values: %s\n", hex.EncodeToString(blob)))
+
+	return blob, true
 }
 
 // processMiddlewares checks that accessPath can be processed by any registered middleware.
 // Contract: if {data} != nil, middleware was found.
-func (server *DSServer) processMiddlewares(path *vm_grpc.VMAccessPath) (data []byte, err error) {
+func (server *DSServer) processMiddlewares(ctx sdk.Context, path *vm_grpc.VMAccessPath) (data []byte, err error) {
 	for _, f := range server.dataMiddlewares {
-		data, err = f(server.ctx, path)
+		data, err = f(ctx, path)
 		if err != nil || data != nil {
 			return
 		}
@@ -131,9 +223,11 @@ func (server *DSServer) processMiddlewares(path *vm_grpc.VMAccessPath) (data []b
 }
 
 // NewDSServer creates a new DS server.
-func NewDSServer(keeper *Keeper) *DSServer {
+func NewDSServer(keeper *Keeper, cacheSize uint) *DSServer {
 	return &DSServer{
-		keeper: keeper,
+		keeper:   keeper,
+		contexts: make(map[string]dsContext),
+		cache:    newDSCache(cacheSize),
 	}
 }
 
@@ -3,15 +3,25 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/dfinance/dvm-proto/go/ds_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
 // Initialize connection to DS server.
@@ -74,6 +84,15 @@ func TestVM_DSServer_MultiGetRaw(t *testing.T) {
 	input := newTestInput(true)
 	defer input.Stop()
 
+	// middleware path
+	middlewarePath, middlewareValue := randomPath(), randomValue(16)
+	input.vk.dsServer.RegisterDataMiddleware(func(_ sdk.Context, path *vm_grpc.VMAccessPath) ([]byte, error) {
+		if bytes.Equal(path.Address, middlewarePath.Address) && bytes.Equal(path.Path, middlewarePath.Path) {
+			return middlewareValue, nil
+		}
+		return nil, nil
+	})
+
 	rawServer := StartServer(input.vk.listener, input.vk.dsServer)
 	defer rawServer.Stop()
 
@@ -82,31 +101,535 @@ func TestVM_DSServer_MultiGetRaw(t *testing.T) {
 	client := getClient(t, input.dsListener)
 	argsCount := 3
 	req := &ds_grpc.DSAccessPaths{
-		Paths: make([]*ds_grpc.DSAccessPath, argsCount),
+		Paths: make([]*ds_grpc.DSAccessPath, 0, argsCount),
 	}
-	values := make([][]byte, argsCount)
+	values := make([][]byte, 0, argsCount)
 
-	for i := 0; i < len(req.Paths); i++ {
+	for i := 0; i < argsCount; i++ {
 		path := &vm_grpc.VMAccessPath{
 			Address: randomValue(32),
 			Path:    randomValue(32),
 		}
+		value := randomValue(8 * (i + 1))
 
-		values[i] = randomValue(8 * (i + 1))
-		req.Paths[i] = &ds_grpc.DSAccessPath{
+		req.Paths = append(req.Paths, &ds_grpc.DSAccessPath{
 			Address: path.Address,
 			Path:    path.Path,
+		})
+		values = append(values, value)
+
+		input.vk.setValue(input.ctx, path, value)
+	}
+
+	// ok: all found
+	{
+		var trailer metadata.MD
+		resp, err := client.MultiGetRaw(context.Background(), req, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+		require.Len(t, resp.Blobs, argsCount)
+		for i, val := range resp.Blobs {
+			require.EqualValues(t, values[i], val)
 		}
+		require.Empty(t, trailer.Get(DSNoDataTrailerKey))
+	}
+
+	// ok: missing paths and middleware path
+	{
+		missingPath := randomPath()
+		partialReq := &ds_grpc.DSAccessPaths{
+			Paths: []*ds_grpc.DSAccessPath{
+				req.Paths[0],
+				{Address: missingPath.Address, Path: missingPath.Path},
+				{Address: middlewarePath.Address, Path: middlewarePath.Path},
+				req.Paths[2],
+				{Address: missingPath.Address, Path: randomValue(32)},
+			},
+		}
+
+		var trailer metadata.MD
+		resp, err := client.MultiGetRaw(context.Background(), partialReq, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+		require.Len(t, resp.Blobs, len(partialReq.Paths))
+		require.EqualValues(t, values[0], resp.Blobs[0])
+		require.Empty(t, resp.Blobs[1])
+		require.EqualValues(t, middlewareValue, resp.Blobs[2])
+		require.EqualValues(t, values[2], resp.Blobs[3])
+		require.Empty(t, resp.Blobs[4])
 
-		input.vk.setValue(input.ctx, path, values[i])
+		require.Equal(t, []string{"1,4"}, trailer.Get(DSNoDataTrailerKey))
+		require.Equal(t, []string{ds_grpc.DSRawResponse_NO_DATA.String()}, trailer.Get(DSErrorCodeTrailerKey))
 	}
 
+	// ok: empty request
+	{
+		resp, err := client.MultiGetRaw(context.Background(), &ds_grpc.DSAccessPaths{})
+		require.NoError(t, err)
+		require.Empty(t, resp.Blobs)
+	}
+
+	// ok: GetRaw and MultiGetRaw consistency
+	{
+		for i, path := range req.Paths {
+			resp, err := client.GetRaw(context.Background(), path)
+			require.NoError(t, err)
+			require.EqualValues(t, values[i], resp.Blob)
+		}
+	}
+}
+
+// Test DS server read cache.
+func TestVM_DSServer_Cache(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+
+	rawServer := StartServer(input.vk.listener, input.vk.dsServer)
+	defer rawServer.Stop()
+
+	input.vk.dsServer.SetContext(input.ctx)
+
+	client := getClient(t, input.dsListener)
 	connCtx := context.Background()
-	resp, err := client.MultiGetRaw(connCtx, req)
-	require.Nil(t, resp)
-	require.Error(t, err)
 
-	/*for i, val := range resp.Blobs {
-		require.EqualValues(t, values[i], val)
-	}*/
+	path, missingPath := randomPath(), randomPath()
+	value1, value2 := randomValue(32), randomValue(32)
+	input.vk.setValue(input.ctx, path, value1)
+
+	getRaw := func(path *vm_grpc.VMAccessPath) *ds_grpc.DSRawResponse {
+		resp, err := client.GetRaw(connCtx, &ds_grpc.DSAccessPath{Address: path.Address, Path: path.Path})
+		require.NoError(t, err)
+		return resp
+	}
+
+	getRawWithContext := func(ctx sdk.Context, path *vm_grpc.VMAccessPath) *ds_grpc.DSRawResponse {
+		id, release := input.vk.dsServer.RegisterContext(ctx)
+		defer release()
+
+		resp, err := client.GetRaw(withDSContextID(connCtx, id), &ds_grpc.DSAccessPath{Address: path.Address, Path: path.Path})
+		require.NoError(t, err)
+		return resp
+	}
+
+	checkMetrics := func(size int, hits, misses uint64) {
+		metrics := input.vk.dsServer.GetCacheMetrics()
+		require.True(t, metrics.Enabled)
+		require.Equal(t, size, metrics.Size, "size")
+		require.Equal(t, hits, metrics.Hits, "hits")
+		require.Equal(t, misses, metrics.Misses, "misses")
+	}
+
+	// reset the cache (setValue above marks key as written)
+	input.vk.dsServer.SetContext(input.ctx)
+
+	// ok: miss, then hit
+	{
+		require.EqualValues(t, value1, getRaw(path).Blob)
+		checkMetrics(1, 0, 1)
+
+		require.EqualValues(t, value1, getRaw(path).Blob)
+		checkMetrics(1, 1, 1)
+	}
+
+	// ok: missing path is cached too
+	{
+		require.Equal(t, ds_grpc.DSRawResponse_NO_DATA, getRaw(missingPath).ErrorCode)
+		require.Equal(t, ds_grpc.DSRawResponse_NO_DATA, getRaw(missingPath).ErrorCode)
+		checkMetrics(2, 2, 2)
+	}
+
+	// ok: write invalidates the key (not cached till the context reset)
+	{
+		input.vk.setValue(input.ctx, path, value2)
+		require.EqualValues(t, value2, getRaw(path).Blob)
+		require.EqualValues(t, value2, getRaw(path).Blob)
+		checkMetrics(1, 2, 4)
+
+		input.vk.processWriteSet(input.ctx, []*vm_grpc.VMValue{
+			{Type: vm_grpc.VmWriteOp_Value, Path: missingPath, Value: value1},
+		})
+		require.EqualValues(t, value1, getRaw(missingPath).Blob)
+		checkMetrics(0, 2, 5)
+	}
+
+	// ok: SetContext resets the cache
+	{
+		input.vk.dsServer.SetContext(input.ctx)
+		checkMetrics(0, 2, 5)
+
+		require.EqualValues(t, value2, getRaw(path).Blob)
+		require.EqualValues(t, value2, getRaw(path).Blob)
+		checkMetrics(1, 3, 6)
+	}
+
+	// ok: deliver request contexts use the cache for keys not written since the reset
+	{
+		txCtx, _ := input.ctx.CacheContext()
+		require.EqualValues(t, value2, getRawWithContext(txCtx, path).Blob)
+		checkMetrics(1, 4, 6)
+	}
+
+	// ok: non-deliver request contexts don't use the cache
+	{
+		queryCtx := WithDSContextKind(input.ctx, DSContextQuery)
+		require.EqualValues(t, value2, getRawWithContext(queryCtx, path).Blob)
+		checkMetrics(1, 4, 6)
+	}
+
+	// ok: failed tx writes are not cached
+	{
+		failedTxCtx, _ := input.ctx.CacheContext()
+		input.vk.setValue(failedTxCtx, path, value1)
+		require.EqualValues(t, value1, getRawWithContext(failedTxCtx, path).Blob)
+		checkMetrics(0, 4, 7)
+
+		txCtx, _ := input.ctx.CacheContext()
+		require.EqualValues(t, value2, getRawWithContext(txCtx, path).Blob)
+		require.EqualValues(t, value2, getRaw(path).Blob)
+		checkMetrics(0, 4, 9)
+	}
+}
+
+// dsReaderVMServer is a DVM mock reading data via DS server the same way DVM does.
+// DS context ID metadata is passed back with DS requests only if {passContextID} is set.
+// Script execution returns {path} value as a writeSet value.
+// Module publish writes the module path (last {dsReaderPathLen} code bytes), if code is longer, the dependency path
+// (first {dsReaderPathLen} code bytes) is read: missing dependency aborts the execution.
+// If {blockQueries} is set, query requests are blocked till the request deadline.
+type dsReaderVMServer struct {
+	dsClient      ds_grpc.DSServiceClient
+	path          *vm_grpc.VMAccessPath
+	passContextID bool
+	blockQueries  bool
+	queryAttempts *uint32
+}
+
+const dsReaderPathLen = 20
+
+// dsRequestCtx builds DS request context passing DS context ID back (if enabled).
+func (server dsReaderVMServer) dsRequestCtx(vmReqCtx context.Context) context.Context {
+	dsReqCtx := context.Background()
+	if !server.passContextID {
+		return dsReqCtx
+	}
+
+	if md, ok := metadata.FromIncomingContext(vmReqCtx); ok {
+		for _, id := range md.Get(DSContextIDMetadataKey) {
+			dsReqCtx = metadata.AppendToOutgoingContext(dsReqCtx, DSContextIDMetadataKey, id)
+		}
+	}
+
+	return dsReqCtx
+}
+
+func (server dsReaderVMServer) ExecuteScript(ctx context.Context, _ *vm_grpc.VMExecuteScript) (*vm_grpc.VMExecuteResponse, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && server.blockQueries {
+		if ids := md.Get(DSContextIDMetadataKey); len(ids) > 0 && strings.HasPrefix(ids[0], string(DSContextQuery)) {
+			atomic.AddUint32(server.queryAttempts, 1)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+	}
+
+	resp, err := server.dsClient.GetRaw(server.dsRequestCtx(ctx), &ds_grpc.DSAccessPath{Address: server.path.Address, Path: server.path.Path})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vm_grpc.VMExecuteResponse{
+		WriteSet: []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: server.path, Value: resp.Blob}},
+		Status:   &vm_grpc.VMStatus{},
+	}, nil
+}
+
+func (server dsReaderVMServer) PublishModule(ctx context.Context, req *vm_grpc.VMPublishModule) (*vm_grpc.VMExecuteResponse, error) {
+	if len(req.Code) > dsReaderPathLen {
+		resp, err := server.dsClient.GetRaw(server.dsRequestCtx(ctx), &ds_grpc.DSAccessPath{Address: req.Sender, Path: req.Code[:dsReaderPathLen]})
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.ErrorCode != ds_grpc.DSRawResponse_NONE {
+			return &vm_grpc.VMExecuteResponse{
+				Status: &vm_grpc.VMStatus{Error: &vm_grpc.VMStatus_Abort{Abort: &vm_grpc.Abort{AbortCode: 1}}},
+			}, nil
+		}
+	}
+
+	modulePath := &vm_grpc.VMAccessPath{Address: req.Sender, Path: req.Code[len(req.Code)-dsReaderPathLen:]}
+
+	return &vm_grpc.VMExecuteResponse{
+		WriteSet: []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: modulePath, Value: req.Code}},
+		Status:   &vm_grpc.VMStatus{},
+	}, nil
+}
+
+// startDSReaderVM starts DVM mock and replaces the keeper client.
+func startDSReaderVM(t *testing.T, input *testInput, dvmMock dsReaderVMServer) (stop func()) {
+	dvmListener := bufconn.Listen(bufferSize)
+	dvmServer := grpc.NewServer()
+	vm_grpc.RegisterVMScriptExecutorServer(dvmServer, dvmMock)
+	vm_grpc.RegisterVMModulePublisherServer(dvmServer, dvmMock)
+	go func() {
+		_ = dvmServer.Serve(dvmListener)
+	}()
+
+	dvmConn, err := grpc.DialContext(context.TODO(), "", grpc.WithContextDialer(getBufDialer(dvmListener)), grpc.WithInsecure())
+	require.NoError(t, err)
+	input.vk.client = NewVMClient(dvmConn)
+
+	return dvmServer.Stop
+}
+
+// Test DSServer request contexts: VM requests of different kinds run concurrently with the block execution.
+func TestVM_DSServer_Contexts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context ID passed", func(t *testing.T) {
+		testDSServerContexts(t, true)
+	})
+
+	t.Run("context ID not passed", func(t *testing.T) {
+		testDSServerContexts(t, false)
+	})
+}
+
+func testDSServerContexts(t *testing.T, passContextID bool) {
+	input := newTestInput(true)
+	defer input.Stop()
+
+	rawServer := StartServer(input.vk.listener, input.vk.dsServer)
+	defer rawServer.Stop()
+
+	client := getClient(t, input.dsListener)
+
+	path := randomPath()
+	committedValue := randomValue(32)
+	input.vk.setValue(input.ctx, path, committedValue)
+
+	stopDVM := startDSReaderVM(t, &input, dsReaderVMServer{dsClient: client, path: path, passContextID: passContextID})
+	defer stopDVM()
+
+	// execScript returns {path} value read by DVM within the request
+	execScript := func(ctx sdk.Context) ([]byte, error) {
+		exec, err := input.vk.sendExecuteReq(ctx, nil, &vm_grpc.VMExecuteScript{})
+		if err != nil {
+			return nil, err
+		}
+
+		return exec.WriteSet[0].Value, nil
+	}
+
+	// block execution context (not committed)
+	deliverCtx, _ := input.ctx.CacheContext()
+	input.vk.dsServer.SetContext(deliverCtx)
+
+	// check context kinds
+	{
+		require.Equal(t, DSContextDeliver, GetDSContextKind(deliverCtx))
+		require.Equal(t, DSContextCheck, GetDSContextKind(deliverCtx.WithIsCheckTx(true)))
+		require.Equal(t, DSContextQuery, GetDSContextKind(WithDSContextKind(deliverCtx, DSContextQuery)))
+	}
+
+	// ok: default context is used if there is no VM request in progress
+	{
+		resp, err := client.GetRaw(context.Background(), &ds_grpc.DSAccessPath{Address: path.Address, Path: path.Path})
+		require.NoError(t, err)
+		require.EqualValues(t, committedValue, resp.Blob)
+	}
+
+	// ok: default context is used for an unknown context ID
+	{
+		connCtx := metadata.AppendToOutgoingContext(context.Background(), DSContextIDMetadataKey, "unknown")
+		resp, err := client.GetRaw(connCtx, &ds_grpc.DSAccessPath{Address: path.Address, Path: path.Path})
+		require.NoError(t, err)
+		require.EqualValues(t, committedValue, resp.Blob)
+	}
+
+	// ok: simulate context sees its own changes, default context (and read cache) is not affected
+	{
+		simCtx, _ := input.ctx.CacheContext()
+		simCtx = WithDSContextKind(simCtx, DSContextSimulate)
+		simValue := randomValue(32)
+		input.vk.setValue(simCtx, path, simValue)
+
+		// SetDSContext must skip non-deliver contexts
+		input.vk.SetDSContext(simCtx)
+
+		value, err := execScript(simCtx)
+		require.NoError(t, err)
+		require.EqualValues(t, simValue, value)
+
+		value, err = execScript(deliverCtx)
+		require.NoError(t, err)
+		require.EqualValues(t, committedValue, value)
+
+		value, err = execScript(simCtx)
+		require.NoError(t, err)
+		require.EqualValues(t, simValue, value)
+	}
+
+	// ok: checkTx context sees its own changes
+	{
+		checkCtx, _ := input.ctx.WithIsCheckTx(true).CacheContext()
+		checkValue := randomValue(32)
+		input.vk.setValue(checkCtx, path, checkValue)
+
+		value, err := execScript(checkCtx)
+		require.NoError(t, err)
+		require.EqualValues(t, checkValue, value)
+	}
+
+	// ok: dry run / simulation modules depend on the previous ones within the same message
+	{
+		signer := sdk.AccAddress(randomValue(20))
+		fooCode := randomValue(dsReaderPathLen)
+		barCode := append(append([]byte{}, fooCode...), randomValue(dsReaderPathLen)...)
+		fooPath := &vm_grpc.VMAccessPath{Address: signer, Path: fooCode}
+
+		msg := types.NewMsgDeployModule(signer, []types.Contract{fooCode, barCode})
+		require.NoError(t, input.vk.DeployContractDryRun(deliverCtx, msg))
+		require.False(t, input.vk.hasValue(deliverCtx, fooPath))
+
+		resp, err := input.vk.SimulateDeployContract(deliverCtx, msg)
+		require.NoError(t, err)
+		require.Len(t, resp.VMStatuses, 2)
+		require.Len(t, resp.WriteSet, 2)
+		for _, status := range resp.VMStatuses {
+			require.Equal(t, types.AttributeValueStatusKeep, status.Status)
+		}
+		require.False(t, input.vk.hasValue(deliverCtx, fooPath))
+
+		// dry run writes are not visible for the deliver context
+		resp, err = input.vk.SimulateDeployContract(deliverCtx, types.NewMsgDeployModule(signer, []types.Contract{barCode}))
+		require.NoError(t, err)
+		require.Len(t, resp.VMStatuses, 1)
+		require.Equal(t, types.AttributeValueStatusDiscard, resp.VMStatuses[0].Status)
+
+		require.Error(t, input.vk.DeployContractDryRun(deliverCtx, types.NewMsgDeployModule(signer, []types.Contract{barCode})))
+	}
+
+	// ok: concurrent queries during the block execution
+	// deliver requests are always served with the deliver context,
+	// query requests are served with the query context only if DVM passes context ID back
+	{
+		queryCtx := WithDSContextKind(input.ctx, DSContextQuery)
+
+		const blockTxs, queryWorkers, queriesPerWorker = 50, 4, 50
+
+		type execResult struct {
+			isQuery  bool
+			expected []byte
+			received []byte
+			err      error
+		}
+		results := make(chan execResult, blockTxs+queryWorkers*queriesPerWorker)
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < blockTxs; i++ {
+				txValue := []byte(fmt.Sprintf("tx_%d", i))
+				input.vk.setValue(deliverCtx, path, txValue)
+
+				value, err := execScript(deliverCtx)
+				results <- execResult{expected: txValue, received: value, err: err}
+			}
+		}()
+
+		for w := 0; w < queryWorkers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < queriesPerWorker; i++ {
+					value, err := execScript(queryCtx)
+					results <- execResult{isQuery: true, expected: committedValue, received: value, err: err}
+				}
+			}()
+		}
+
+		wg.Wait()
+		close(results)
+
+		for result := range results {
+			require.NoError(t, result.err)
+			if !result.isQuery || passContextID {
+				require.EqualValues(t, result.expected, result.received)
+			}
+		}
+
+		value, err := execScript(queryCtx)
+		require.NoError(t, err)
+		require.EqualValues(t, committedValue, value)
+	}
+}
+
+// Test non-deliver VM requests are not retried and don't block deliver requests.
+func TestVM_DSServer_QueryNotBlockingDeliver(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+
+	rawServer := StartServer(input.vk.listener, input.vk.dsServer)
+	defer rawServer.Stop()
+
+	client := getClient(t, input.dsListener)
+
+	path := randomPath()
+	value := randomValue(32)
+	input.vk.setValue(input.ctx, path, value)
+	input.vk.dsServer.SetContext(input.ctx)
+
+	queryAttempts := uint32(0)
+	stopDVM := startDSReaderVM(t, &input, dsReaderVMServer{
+		dsClient:      client,
+		path:          path,
+		passContextID: true,
+		blockQueries:  true,
+		queryAttempts: &queryAttempts,
+	})
+	defer stopDVM()
+
+	// deliver requests are retried infinitely
+	input.vk.config.MaxAttempts = 0
+	input.vk.config.ReqTimeoutInMs = 0
+	input.vk.config.QueryReqTimeoutInMs = 500
+
+	queryErrCh := make(chan error, 1)
+	go func() {
+		_, err := input.vk.sendExecuteReq(WithDSContextKind(input.ctx, DSContextQuery), nil, &vm_grpc.VMExecuteScript{})
+		queryErrCh <- err
+	}()
+
+	// wait for the query request to reach DVM
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&queryAttempts) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// ok: deliver request is executed while the query request is in progress
+	{
+		deliverCtx, _ := input.ctx.CacheContext()
+		exec, err := input.vk.sendExecuteReq(deliverCtx, nil, &vm_grpc.VMExecuteScript{})
+		require.NoError(t, err)
+		require.EqualValues(t, value, exec.WriteSet[0].Value)
+
+		select {
+		case <-queryErrCh:
+			t.Fatal("query request must be in progress")
+		default:
+		}
+	}
+
+	// fail: query request fails after a single attempt with the query timeout
+	{
+		select {
+		case err := <-queryErrCh:
+			require.Error(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("query request must fail with the timeout")
+		}
+		require.EqualValues(t, 1, atomic.LoadUint32(&queryAttempts))
+	}
 }
@@ -9,8 +9,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 	"google.golang.org/grpc"
 
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
@@ -27,17 +29,24 @@ var _ common_vm.VMStorage = Keeper{}
 
 // Module keeper object.
 type Keeper struct {
-	cdc      *amino.Codec
-	storeKey sdk.StoreKey
+	cdc        *amino.Codec
+	storeKey   sdk.StoreKey
+	tStoreKey  sdk.StoreKey
+	paramStore params.Subspace
 	//
 	config *config.VMConfig
 	// VM connection
-	client    VMClient         // aggregated gRPC services client
-	rawClient *grpc.ClientConn // gRPC connection
+	client      VMClient         // aggregated gRPC services client
+	rawClient   *grpc.ClientConn // gRPC connection
+	connMonitor *connMonitor     // connection health checker (nil if disabled)
 	// DataSource server
 	listener    net.Listener
 	dsServer    *DSServer
 	rawDSServer *grpc.Server
+	// WriteSets indexer DB (nil if disabled)
+	writeSetIndex dbm.DB
+	// Events indexer DB (nil if disabled)
+	eventIndex dbm.DB
 	//
 	modulePerms perms.ModulePermissions
 }
@@ -51,7 +60,7 @@ func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 func (k Keeper) ExecuteScript(ctx sdk.Context, msg types.MsgExecuteScript) error {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
-	req, sdkErr := NewExecuteRequest(ctx, msg)
+	req, sdkErr := NewExecuteRequest(ctx, k.GetParams(ctx), msg)
 	if sdkErr != nil {
 		return sdkErr
 	}
@@ -71,7 +80,7 @@ func (k Keeper) ExecuteScript(ctx sdk.Context, msg types.MsgExecuteScript) error
 func (k Keeper) ExecuteScriptNoProcessing(ctx sdk.Context, msg types.MsgExecuteScript) (*vm_grpc.VMExecuteResponse, error) {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
-	req, sdkErr := NewExecuteRequest(ctx, msg)
+	req, sdkErr := NewExecuteRequest(ctx, k.GetParams(ctx), msg)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -85,36 +94,47 @@ func (k Keeper) ExecuteScriptNoProcessing(ctx sdk.Context, msg types.MsgExecuteS
 }
 
 // DeployContract deploys Move module (contract) and processes execution results (events, writeSets).
+// Modules are published one by one, so a module can depend on the previous ones within the same message.
 func (k Keeper) DeployContract(ctx sdk.Context, msg types.MsgDeployModule) error {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
-	execList := make([]*vm_grpc.VMExecuteResponse, len(msg.Module))
-	var intErr error
+	params := k.GetParams(ctx)
+	for _, contract := range msg.Module {
+		ctx.GasMeter().ConsumeGas(params.GetPublishGas(len(contract)), "vm module publish")
+		req := NewDeployRequest(ctx, params, msg.Signer, contract)
 
-	for i, contract := range msg.Module {
-		req := NewDeployRequest(ctx, msg.Signer, contract)
-
-		execList[i], intErr = k.sendExecuteReq(ctx, req, nil)
-		if intErr != nil {
-			k.GetLogger(ctx).Error(fmt.Sprintf("grpc error: %s", intErr.Error()))
-			panic(sdkErrors.Wrap(types.ErrVMCrashed, intErr.Error()))
+		exec, err := k.sendExecuteReq(ctx, req, nil)
+		if err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("grpc error: %s", err.Error()))
+			panic(sdkErrors.Wrap(types.ErrVMCrashed, err.Error()))
 		}
-	}
 
-	for _, exec := range execList {
 		k.processExecution(ctx, exec)
+		k.registerModules(ctx, msg.Signer, msg.Source, exec)
 	}
 
 	return nil
 }
 
 // DeployContractDryRun checks that contract can be deployed (returned writeSets are not persisted to store).
+// WriteSets are applied to the cache context, so a module can depend on the previous ones within the same message.
 func (k Keeper) DeployContractDryRun(ctx sdk.Context, msg types.MsgDeployModule) error {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
+	cacheCtx, _ := ctx.CacheContext()
+	var writeSet []*vm_grpc.VMValue
+	defer func() {
+		// drop DS read cache entries which might contain cache context values
+		for _, value := range writeSet {
+			k.dsServer.invalidateCache(common_vm.GetPathKey(value.Path))
+		}
+	}()
+
+	params := k.GetParams(ctx)
 	for _, contact := range msg.Module {
-		req := NewDeployRequest(ctx, msg.Signer, contact)
-		exec, dvmErr := k.sendExecuteReq(ctx, req, nil)
+		cacheCtx.GasMeter().ConsumeGas(params.GetPublishGas(len(contact)), "vm module publish")
+		req := NewDeployRequest(cacheCtx, params, msg.Signer, contact)
+		exec, dvmErr := k.sendExecuteReq(cacheCtx, req, nil)
 		if dvmErr != nil {
 			cErr := fmt.Sprintf("contract: %s error: %s", contact, dvmErr.Error())
 			return sdkErrors.Wrap(types.ErrVMCrashed, cErr)
@@ -125,6 +145,9 @@ func (k Keeper) DeployContractDryRun(ctx sdk.Context, msg types.MsgDeployModule)
 			cErr := fmt.Sprintf("contract: %s error: %s", contact, statusMsg)
 			return sdkErrors.Wrap(types.ErrWrongExecutionResponse, cErr)
 		}
+
+		writeSet = append(writeSet, exec.WriteSet...)
+		k.processWriteSet(cacheCtx, exec.WriteSet)
 	}
 
 	return nil
@@ -134,27 +157,65 @@ func (k Keeper) DeployContractDryRun(ctx sdk.Context, msg types.MsgDeployModule)
 func NewKeeper(
 	cdc *amino.Codec,
 	storeKey sdk.StoreKey,
+	tStoreKey sdk.StoreKey,
+	paramStore params.Subspace,
 	conn *grpc.ClientConn,
 	listener net.Listener,
 	config *config.VMConfig,
+	options []Option,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	keeper := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		rawClient:   conn,
-		client:      NewVMClient(conn),
-		listener:    listener,
-		config:      config,
-		modulePerms: types.NewModulePerms(),
+		cdc:           cdc,
+		storeKey:      storeKey,
+		tStoreKey:     tStoreKey,
+		paramStore:    paramStore.WithKeyTable(types.ParamKeyTable()),
+		rawClient:     conn,
+		client:        NewVMClient(conn),
+		listener:      listener,
+		config:        config,
+		writeSetIndex: newWriteSetIndexDB(config),
+		eventIndex:    newEventIndexDB(config),
+		modulePerms:   types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
 		keeper.modulePerms.AutoAddRequester(requester)
 	}
 
-	keeper.dsServer = NewDSServer(&keeper)
+	dsCacheSize := uint(0)
+	if config != nil {
+		dsCacheSize = config.DSCacheSize
+	}
+
+	keeper.dsServer = NewDSServer(&keeper, dsCacheSize)
 	keeper.dsServer.RegisterDataMiddleware(middlewares.NewBlockMiddleware())
 	keeper.dsServer.RegisterDataMiddleware(middlewares.NewTimeMiddleware())
+	keeper.dsServer.RegisterDataMiddleware(middlewares.NewChainInfoMiddleware())
+
+	for _, option := range options {
+		option(&keeper)
+	}
+	keeper.connMonitor = newConnMonitor(conn, keeper.client, config)
 
 	return keeper
 }
+
+// Option defines optional Keeper configuration applied by NewKeeper.
+type Option func(k *Keeper)
+
+// WithVMClient replaces the default gRPC VM client (used to plug in-process VM backends for tests).
+func WithVMClient(client VMClient) Option {
+	return func(k *Keeper) {
+		k.client = client
+	}
+}
+
+// WithDSDataMiddlewares registers DS server data middlewares contributed by other modules (chain data exposed to Move).
+// Middlewares are called in the registration order after the default ones.
+func WithDSDataMiddlewares(mds ...common_vm.DSDataMiddleware) Option {
+	return func(k *Keeper) {
+		for _, md := range mds {
+			k.dsServer.RegisterDataMiddleware(md)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
 
 	"github.com/dfinance/dnode/x/vm/internal/types"
@@ -36,17 +37,42 @@ func (k *Keeper) StartDSServer(ctx sdk.Context) {
 	}
 }
 
-// SetDSContext sets DataSource server context (storage context should be updated periodically to provide actual data).
+// SetDSContext sets DataSource server default context (storage context should be updated periodically to provide actual data).
+// Contexts explicitly marked as non-deliver are skipped: they are set per VM request.
 func (k Keeper) SetDSContext(ctx sdk.Context) {
 	k.modulePerms.AutoCheck(types.PermDsAdmin)
 
+	if kind, ok := getExplicitDSContextKind(ctx); ok && kind != DSContextDeliver {
+		return
+	}
+
 	k.dsServer.SetContext(ctx.WithGasMeter(types.NewDumbGasMeter()))
 }
 
-// CloseConnections stops DataSource server and close connection to VM.
+// StartConnMonitor starts VM connection health checks (if enabled).
+func (k Keeper) StartConnMonitor(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermDsAdmin)
+
+	if k.connMonitor != nil {
+		k.connMonitor.Start(k.GetLogger(ctx))
+	}
+}
+
+// CheckVMAvailability returns the retryable ErrVMUnavailable error if the last VM connection health check has failed.
+func (k Keeper) CheckVMAvailability() error {
+	k.modulePerms.AutoCheck(types.PermVmExec)
+
+	return k.checkVMAvailability()
+}
+
+// CloseConnections stops DataSource server, VM connection health checks, closes connection to VM and writeSets indexer DB.
 func (k Keeper) CloseConnections() {
 	k.modulePerms.AutoCheck(types.PermDsAdmin)
 
+	if k.connMonitor != nil {
+		k.connMonitor.Stop()
+	}
+
 	if k.rawDSServer != nil {
 		k.rawDSServer.Stop()
 	}
@@ -54,68 +80,76 @@ func (k Keeper) CloseConnections() {
 	if k.rawClient != nil {
 		k.rawClient.Close()
 	}
+
+	if k.writeSetIndex != nil {
+		k.writeSetIndex.Close()
+	}
 }
 
 // retryExecReq sends request with retry mechanism and waits for connection and execution.
 // Contract: either RawModule or RawScript must be specified for RetryExecReq.
 func (k Keeper) retryExecReq(ctx sdk.Context, req RetryExecReq) (retResp *vm_grpc.VMExecuteResponse, retErr error) {
+	// register DS context for the request
+	dsCtxID, dsCtxRelease := k.dsServer.RegisterContext(ctx)
+	defer dsCtxRelease()
+
+	retErr = k.retryReq(ctx, withDSContextID(context.Background(), dsCtxID), req.MaxAttempts, req.ReqTimeoutInMs, func(connCtx context.Context) error {
+		var err error
+		if req.RawModule != nil {
+			retResp, err = k.client.PublishModule(connCtx, req.RawModule)
+		} else if req.RawScript != nil {
+			retResp, err = k.client.ExecuteScript(connCtx, req.RawScript)
+		}
+
+		return err
+	})
+
+	return
+}
+
+// retryReq sends VM request with retry mechanism: {doReq} is called until success or {maxAttempts} is reached.
+// Request timeout is applied per attempt, failed attempts are delayed with backoff (and paced to the request timeout).
+func (k Keeper) retryReq(ctx sdk.Context, baseCtx context.Context, maxAttempts, reqTimeoutInMs uint, doReq func(connCtx context.Context) error) (retErr error) {
 	const failedRetryLogPeriod = 100
 
-	doneCh := make(chan bool)
 	curAttempt := uint(0)
-	reqTimeout := time.Duration(req.ReqTimeoutInMs) * time.Millisecond
+	reqTimeout := time.Duration(reqTimeoutInMs) * time.Millisecond
+	backoff := newRetryBackoff(k.config)
 	reqStartedAt := time.Now()
 
-	go func() {
-		defer func() {
-			close(doneCh)
-		}()
-
-		for {
-			var connCtx context.Context
-			var connCancel context.CancelFunc
-			var resp *vm_grpc.VMExecuteResponse
-			var err error
-
-			curAttempt++
-
-			connCtx = context.Background()
-			if reqTimeout > 0 {
-				connCtx, connCancel = context.WithTimeout(context.Background(), reqTimeout)
-			}
-
-			curReqStartedAt := time.Now()
-			if req.RawModule != nil {
-				resp, err = k.client.VMModulePublisherClient.PublishModule(connCtx, req.RawModule)
-			} else if req.RawScript != nil {
-				resp, err = k.client.VMScriptExecutorClient.ExecuteScript(connCtx, req.RawScript)
-			}
-			if connCancel != nil {
-				connCancel()
-			}
-			curReqDur := time.Since(curReqStartedAt)
-
-			if err == nil {
-				retResp, retErr = resp, nil
-				return
-			}
-
-			if req.MaxAttempts != 0 && curAttempt == req.MaxAttempts {
-				retResp, retErr = nil, err
-				return
-			}
-
-			if curReqDur < reqTimeout {
-				time.Sleep(reqTimeout - curReqDur)
-			}
-
-			if curAttempt % failedRetryLogPeriod == 0 {
-				msg := fmt.Sprintf("Failing VM request: attempt %d / %d with %v timeout: %v", curAttempt, req.MaxAttempts, reqTimeout, time.Since(reqStartedAt))
-				k.GetLogger(ctx).Info(msg)
-			}
+	for {
+		connCtx, connCancel := baseCtx, context.CancelFunc(func() {})
+		if reqTimeout > 0 {
+			connCtx, connCancel = context.WithTimeout(baseCtx, reqTimeout)
+		}
+
+		curAttempt++
+		curReqStartedAt := time.Now()
+		err := doReq(connCtx)
+		connCancel()
+		curReqDur := time.Since(curReqStartedAt)
+
+		if err == nil {
+			retErr = nil
+			break
+		}
+
+		if maxAttempts != 0 && curAttempt == maxAttempts {
+			retErr = err
+			break
+		}
+
+		delay := backoff.Next()
+		if curReqDur < reqTimeout && reqTimeout-curReqDur > delay {
+			delay = reqTimeout - curReqDur
 		}
-	}()
-	<-doneCh
+		time.Sleep(delay)
+
+		if curAttempt%failedRetryLogPeriod == 0 {
+			msg := fmt.Sprintf("Failing VM request: attempt %d / %s with %v timeout: %v", curAttempt, getMaxAttemptsStr(int(maxAttempts)), reqTimeout, time.Since(reqStartedAt))
+			k.GetLogger(ctx).Info(msg)
+		}
+	}
 
 	reqDur := time.Since(reqStartedAt)
 	msg := fmt.Sprintf("in %d attempt(s) with %v timeout (%v)", curAttempt, reqTimeout, reqDur)
@@ -145,9 +179,28 @@ func (k Keeper) sendExecuteReq(ctx sdk.Context, moduleReq *vm_grpc.VMPublishModu
 		ReqTimeoutInMs: k.config.ReqTimeoutInMs,
 	}
 
+	// non-consensus requests (check, query, simulation) fail fast while VM is unavailable and are not retried
+	if GetDSContextKind(ctx) != DSContextDeliver {
+		if err := k.checkVMAvailability(); err != nil {
+			return nil, err
+		}
+
+		retryReq.MaxAttempts = 1
+		retryReq.ReqTimeoutInMs = k.config.QueryReqTimeoutInMs
+	}
+
 	return k.retryExecReq(ctx, retryReq)
 }
 
+// checkVMAvailability checks the last VM connection health check status.
+func (k Keeper) checkVMAvailability() error {
+	if k.connMonitor == nil || k.connMonitor.IsHealthy() {
+		return nil
+	}
+
+	return sdkErrors.Wrap(types.ErrVMUnavailable, k.connMonitor.LastError().Error())
+}
+
 // getMaxAttemptsStr converts max attempts amount to string representation.
 func getMaxAttemptsStr(maxAttempts int) string {
 	if maxAttempts == 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
 func TestVMKeeper_RetryMechanism(t *testing.T) {
@@ -37,7 +38,7 @@ func TestVMKeeper_RetryMechanism(t *testing.T) {
 	keeper.rawClient = mockDvmCLient
 	keeper.client = NewVMClient(mockDvmCLient)
 
-	deployReq := NewDeployRequest(ctx, common_vm.StdLibAddress, []byte{0x01, 0x02, 0x03, 0x04, 0x05})
+	deployReq := NewDeployRequest(ctx, types.DefaultParams(), common_vm.StdLibAddress, []byte{0x01, 0x02, 0x03, 0x04, 0x05})
 
 	// ok: in one attempt (infinite settings)
 	{
@@ -138,3 +139,96 @@ func TestVMKeeper_RetryMechanism(t *testing.T) {
 		require.Contains(t, err.Error(), "failing gRPC execution")
 	}
 }
+
+func TestVMKeeper_ConnectionRecovery(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+	ctx, keeper := input.ctx, input.vk
+
+	// start mockDVM gRPC server (module publisher, metadata health check)
+	listenerAddr, _, err := server.FreeTCPAddr()
+	require.NoError(t, err, "geting free TCP port for MockDVM listener")
+
+	mockDvmListener, err := helpers.GetGRpcNetListener(listenerAddr)
+	require.NoError(t, err, "creating MockDVM listener")
+
+	mockDvmServer := mockdvm.StartMockDVMService(mockDvmListener)
+	defer func() { mockDvmServer.Stop() }()
+
+	// create mockDVM gRPC client with health checks and rewrite test keeper's one
+	keeper.config.MaxAttempts, keeper.config.ReqTimeoutInMs = 0, 1000
+	keeper.config.RetryInitialBackoffInMs, keeper.config.RetryMaxBackoffInMs, keeper.config.RetryBackoffMultiplier = 10, 100, 2.0
+	keeper.config.HealthCheckPeriodInMs, keeper.config.HealthCheckTimeoutInMs = 50, 50
+
+	mockDvmCLient, err := helpers.GetGRpcClientConnection(listenerAddr, 1*time.Second)
+	require.NoError(t, err, "creating MockDVM client")
+	keeper.rawClient = mockDvmCLient
+	keeper.client = NewVMClient(mockDvmCLient)
+	keeper.connMonitor = newConnMonitor(mockDvmCLient, keeper.client, keeper.config)
+	keeper.StartConnMonitor(ctx)
+	defer keeper.connMonitor.Stop()
+
+	deployReq := NewDeployRequest(ctx, types.DefaultParams(), common_vm.StdLibAddress, []byte{0x01, 0x02, 0x03, 0x04, 0x05})
+	mockDvmServer.SetExecutionDelay(10 * time.Millisecond)
+
+	// ok: VM is available
+	{
+		require.NoError(t, keeper.CheckVMAvailability())
+
+		_, err := keeper.sendExecuteReq(ctx, deployReq, nil)
+		require.NoError(t, err)
+	}
+
+	// fail: VM is unavailable, simulation fails fast
+	{
+		mockDvmServer.SetUnavailable()
+
+		require.Eventually(t, func() bool {
+			return types.ErrVMUnavailable.Is(keeper.CheckVMAvailability())
+		}, 5*time.Second, 10*time.Millisecond)
+
+		_, err := keeper.sendExecuteReq(WithDSContextKind(ctx, DSContextSimulate), deployReq, nil)
+		require.True(t, types.ErrVMUnavailable.Is(err))
+	}
+
+	// ok: deliver request waits for VM with backoff
+	{
+		unavailableDur := 500 * time.Millisecond
+		go func() {
+			time.Sleep(unavailableDur)
+			mockDvmServer.SetAvailable()
+		}()
+
+		reqStartedAt := time.Now()
+		_, err := keeper.sendExecuteReq(ctx, deployReq, nil)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, int64(time.Since(reqStartedAt)), int64(unavailableDur))
+
+		require.Eventually(t, func() bool {
+			return keeper.CheckVMAvailability() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// ok: VM restart (connection reestablished)
+	{
+		mockDvmServer.Stop()
+
+		require.Eventually(t, func() bool {
+			return types.ErrVMUnavailable.Is(keeper.CheckVMAvailability())
+		}, 5*time.Second, 10*time.Millisecond)
+
+		mockDvmListener, err := helpers.GetGRpcNetListener(listenerAddr)
+		require.NoError(t, err, "recreating MockDVM listener")
+		mockDvmServer = mockdvm.StartMockDVMService(mockDvmListener)
+		mockDvmServer.SetExecutionDelay(10 * time.Millisecond)
+
+		require.Eventually(t, func() bool {
+			return keeper.CheckVMAvailability() == nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		_, err = keeper.sendExecuteReq(ctx, deployReq, nil)
+		require.NoError(t, err)
+	}
+}
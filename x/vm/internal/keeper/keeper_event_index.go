@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+const (
+	eventIndexDBName = "vm_event_index"
+	// Decoded event data field name for non-struct event types
+	eventDecodeValueFieldName = "value"
+)
+
+// GetEvents returns indexed VM events filtered by type / sender within the heights range with pagination.
+func (k Keeper) GetEvents(params types.EventsReq) (types.EventIndexItems, error) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	if k.eventIndex == nil {
+		return nil, sdkErrors.Wrap(types.ErrIndexDisabled, "events")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "request: %v", err)
+	}
+
+	skipCnt, limitCnt := params.Page.SubUint64(1).Mul(params.Limit).Uint64(), params.Limit.Uint64()
+
+	// select the index: secondary indices values are item keys
+	prefix, isSecondary := types.EventIndexItemPrefix, false
+	switch {
+	case params.EventType != "":
+		prefix, isSecondary = types.GetEventIndexTypePrefix(params.EventType), true
+	case params.Sender != "":
+		prefix, isSecondary = types.GetEventIndexSenderPrefix(params.Sender), true
+	}
+
+	startKey, endKey := types.GetEventIndexHeightKey(prefix, params.FromHeight), sdk.PrefixEndBytes(prefix)
+	if params.ToHeight > 0 {
+		endKey = types.GetEventIndexHeightKey(prefix, params.ToHeight+1)
+	}
+
+	iterator, err := k.eventIndex.Iterator(startKey, endKey)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "indexer DB iterator: %v", err)
+	}
+	defer iterator.Close()
+
+	items := make(types.EventIndexItems, 0)
+	for ; iterator.Valid() && uint64(len(items)) < limitCnt; iterator.Next() {
+		bz := iterator.Value()
+		if isSecondary {
+			if bz, err = k.eventIndex.Get(bz); err != nil {
+				return nil, sdkErrors.Wrapf(types.ErrInternal, "indexer DB read: %v", err)
+			}
+			if bz == nil {
+				continue
+			}
+		}
+
+		var item types.EventIndexItem
+		k.cdc.MustUnmarshalBinaryBare(bz, &item)
+
+		if params.Sender != "" && item.Sender != params.Sender {
+			continue
+		}
+
+		if skipCnt > 0 {
+			skipCnt--
+			continue
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// FlushEventIndex moves the current block transactions VM events from the transient storage to the indexer DB.
+func (k Keeper) FlushEventIndex(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermStorageWrite)
+
+	if k.eventIndex == nil {
+		return
+	}
+
+	store := k.getIndexStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.TxEventsPrefix)
+	defer iterator.Close()
+
+	batch := k.eventIndex.NewBatch()
+	defer batch.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		txHash := iterator.Key()[len(types.TxEventsPrefix):]
+
+		var items types.EventIndexItems
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &items)
+
+		for _, item := range items {
+			item.TxHash = hex.EncodeToString(txHash)
+			item.Height = ctx.BlockHeight()
+
+			itemKey := types.GetEventIndexItemKey(item.Height, txHash, item.Index)
+			batch.Set(itemKey, k.cdc.MustMarshalBinaryBare(item))
+			batch.Set(types.GetEventIndexTypeKey(item.Type, item.Height, txHash, item.Index), itemKey)
+			batch.Set(types.GetEventIndexSenderKey(item.Sender, item.Height, txHash, item.Index), itemKey)
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		k.GetLogger(ctx).Error(fmt.Sprintf("events indexer DB write: %v", err))
+	}
+}
+
+// processEvents converts VM events to SDK events (optionally decoding events data), emits and indexes them.
+func (k Keeper) processEvents(ctx sdk.Context, vmEvents []*vm_grpc.VMEvent) {
+	var decoder *viewerMetaBuilder
+	if k.config != nil && k.config.EventDecodeEnabled {
+		// decoding must not affect the consensus (gas)
+		decoder = &viewerMetaBuilder{
+			keeper:  k,
+			ctx:     ctx.WithGasMeter(sdk.NewInfiniteGasMeter()),
+			modules: make(map[string]*metadata_grpc.ModuleMeta),
+		}
+	}
+
+	events := make(sdk.Events, 0, len(vmEvents))
+	for _, vmEvent := range vmEvents {
+		// panic on "out of gas", emitted events stays in the EventManager
+		event := types.NewMoveEvent(ctx.GasMeter(), vmEvent)
+
+		if decoder != nil {
+			dataJSON, err := decoder.decodeEventData(vmEvent)
+			if err != nil {
+				k.GetLogger(ctx).Debug(fmt.Sprintf("VM event data decoding: %v", err))
+			} else {
+				event = event.AppendAttributes(sdk.NewAttribute(types.AttributeVmEventDataJSON, dataJSON))
+			}
+		}
+
+		ctx.EventManager().EmitEvent(event)
+		events = append(events, event)
+	}
+
+	k.indexEvents(ctx, events)
+}
+
+// indexEvents appends transaction VM events to the transient storage.
+// Only deliver transactions are indexed, failed transactions are dropped with the transient storage cache.
+func (k Keeper) indexEvents(ctx sdk.Context, events sdk.Events) {
+	if k.eventIndex == nil || len(events) == 0 || len(ctx.TxBytes()) == 0 || GetDSContextKind(ctx) != DSContextDeliver {
+		return
+	}
+
+	store := k.getIndexStore(ctx)
+	key := types.GetTxEventsKey(tmhash.Sum(ctx.TxBytes()))
+
+	var items types.EventIndexItems
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &items)
+	}
+
+	for _, event := range events {
+		items = append(items, types.NewEventIndexItem(event, uint32(len(items))))
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(items))
+}
+
+// decodeEventData decodes VM event LCS data to JSON string using module metadata.
+// Non-struct event data is wrapped into an object with the single "value" field.
+func (b viewerMetaBuilder) decodeEventData(vmEvent *vm_grpc.VMEvent) (string, error) {
+	eventType, err := types.NewMoveTypeFromLcsTag(vmEvent.EventType)
+	if err != nil {
+		return "", fmt.Errorf("event type: %w", err)
+	}
+
+	var request types.ViewerRequest
+	if eventType.Kind == types.ViewerTypeStruct && !eventType.IsOption() {
+		if request, err = b.buildStruct(eventType, 0); err != nil {
+			return "", fmt.Errorf("building viewer request: %w", err)
+		}
+	} else {
+		item, err := b.buildItem(eventDecodeValueFieldName, eventType, nil, 0)
+		if err != nil {
+			return "", fmt.Errorf("building viewer request: %w", err)
+		}
+		request = types.ViewerRequest{item}
+	}
+	request = request.WithDefaultFormats(types.ViewerFormatHex, types.ViewerFormatBech32)
+
+	output, err := StringifyLCSData(request, vmEvent.EventData)
+	if err != nil {
+		return "", err
+	}
+
+	buf := bytes.Buffer{}
+	if err := json.Compact(&buf, []byte(output)); err != nil {
+		return "", fmt.Errorf("result JSON compact: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// newEventIndexDB opens events indexer DB if enabled.
+func newEventIndexDB(config *config.VMConfig) dbm.DB {
+	if config == nil || !config.EventIndexEnabled {
+		return nil
+	}
+
+	db, err := dbm.NewGoLevelDB(eventIndexDBName, config.EventIndexDir)
+	if err != nil {
+		panic(fmt.Errorf("opening VM events indexer DB at %q: %w", config.EventIndexDir, err))
+	}
+
+	return db
+}
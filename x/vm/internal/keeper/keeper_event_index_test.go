@@ -0,0 +1,196 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test events indexer: data decoding, transient storage buffering, flush and query filters.
+func TestVMKeeper_EventIndex(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	// ok: disabled
+	{
+		_, err := input.vk.GetEvents(types.EventsReq{Page: sdk.OneUint(), Limit: sdk.OneUint()})
+		require.True(t, types.ErrIndexDisabled.Is(err))
+	}
+
+	input.vk.eventIndex = dbm.NewMemDB()
+	input.vk.config.EventDecodeEnabled = true
+
+	senderAddr := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	newU64Event := func(sender []byte, value byte) *vm_grpc.VMEvent {
+		return &vm_grpc.VMEvent{
+			SenderAddress: sender,
+			EventType:     &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU64},
+			EventData:     []byte{value, 0, 0, 0, 0, 0, 0, 0},
+		}
+	}
+	bytesEvent := &vm_grpc.VMEvent{
+		SenderAddress: senderAddr,
+		EventType: &vm_grpc.LcsTag{
+			TypeTag:    vm_grpc.LcsType_LcsVector,
+			VectorType: &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU8},
+		},
+		EventData: []byte{2, 0xAB, 0xCD},
+	}
+
+	txBytes1, txBytes2 := randomValue(64), randomValue(64)
+	txHash1, txHash2 := tmhash.Sum(txBytes1), tmhash.Sum(txBytes2)
+	// transient storage is reset on commit: emulated with separate cache contexts
+	ctx10, _ := input.ctx.WithBlockHeight(10).WithTxBytes(txBytes1).CacheContext()
+	ctx11, _ := input.ctx.WithBlockHeight(11).WithTxBytes(txBytes2).CacheContext()
+
+	// ok: data decoding
+	{
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		input.vk.processEvents(ctx, []*vm_grpc.VMEvent{newU64Event(common_vm.StdLibAddress, 1), bytesEvent})
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+
+		item1, item2 := types.NewEventIndexItem(events[0], 0), types.NewEventIndexItem(events[1], 1)
+		require.Equal(t, `{"Value":1}`, item1.DataJSON)
+		require.Equal(t, `{"Value":"abcd"}`, item2.DataJSON)
+		require.Equal(t, hex.EncodeToString(bytesEvent.EventData), item2.Data)
+	}
+
+	// ok: non-tx and simulate contexts are not indexed
+	{
+		input.vk.processEvents(input.ctx, []*vm_grpc.VMEvent{newU64Event(common_vm.StdLibAddress, 1)})
+		simCtx, _ := ctx10.CacheContext()
+		input.vk.processEvents(WithDSContextKind(simCtx, DSContextSimulate), []*vm_grpc.VMEvent{newU64Event(common_vm.StdLibAddress, 1)})
+
+		input.vk.FlushEventIndex(ctx10)
+		items, err := input.vk.GetEvents(types.EventsReq{Page: sdk.OneUint(), Limit: sdk.NewUint(100)})
+		require.NoError(t, err)
+		require.Empty(t, items)
+	}
+
+	// index: 3 events at height 10 (two executions), 2 events at height 11
+	{
+		input.vk.processEvents(ctx10, []*vm_grpc.VMEvent{newU64Event(common_vm.StdLibAddress, 1), bytesEvent})
+		input.vk.processEvents(ctx10, []*vm_grpc.VMEvent{newU64Event(senderAddr, 2)})
+		input.vk.FlushEventIndex(ctx10)
+
+		input.vk.processEvents(ctx11, []*vm_grpc.VMEvent{newU64Event(senderAddr, 3), newU64Event(common_vm.StdLibAddress, 4)})
+		input.vk.FlushEventIndex(ctx11)
+	}
+
+	getEvents := func(req types.EventsReq) types.EventIndexItems {
+		if req.Page == (sdk.Uint{}) {
+			req.Page, req.Limit = sdk.OneUint(), sdk.NewUint(100)
+		}
+
+		items, err := input.vk.GetEvents(req)
+		require.NoError(t, err)
+
+		return items
+	}
+	u64Type, bytesType := "u64", "vector<u8>"
+	stdSender, accSender := types.StringifySenderAddress(common_vm.StdLibAddress), types.StringifySenderAddress(senderAddr)
+
+	// ok: all
+	{
+		items := getEvents(types.EventsReq{})
+		require.Len(t, items, 5)
+
+		require.Equal(t, hex.EncodeToString(txHash1), items[0].TxHash)
+		require.EqualValues(t, 10, items[0].Height)
+		require.EqualValues(t, 0, items[0].Index)
+		require.Equal(t, stdSender, items[0].Sender)
+		require.Equal(t, types.AttributeValueSourceScript, items[0].Source)
+		require.Equal(t, u64Type, items[0].Type)
+		require.Equal(t, `{"Value":1}`, items[0].DataJSON)
+
+		require.EqualValues(t, 2, items[2].Index)
+		require.Equal(t, `{"Value":2}`, items[2].DataJSON)
+
+		require.Equal(t, hex.EncodeToString(txHash2), items[3].TxHash)
+		require.EqualValues(t, 11, items[3].Height)
+		require.EqualValues(t, 0, items[3].Index)
+	}
+
+	// ok: by type
+	{
+		require.Len(t, getEvents(types.EventsReq{EventType: u64Type}), 4)
+		require.Len(t, getEvents(types.EventsReq{EventType: bytesType}), 1)
+		require.Empty(t, getEvents(types.EventsReq{EventType: "u128"}))
+	}
+
+	// ok: by sender
+	{
+		items := getEvents(types.EventsReq{Sender: accSender})
+		require.Len(t, items, 3)
+		for _, item := range items {
+			require.Equal(t, accSender, item.Sender)
+		}
+	}
+
+	// ok: by type and sender
+	{
+		items := getEvents(types.EventsReq{EventType: u64Type, Sender: stdSender})
+		require.Len(t, items, 2)
+		require.Equal(t, `{"Value":1}`, items[0].DataJSON)
+		require.Equal(t, `{"Value":4}`, items[1].DataJSON)
+	}
+
+	// ok: heights range
+	{
+		require.Len(t, getEvents(types.EventsReq{FromHeight: 11}), 2)
+		require.Len(t, getEvents(types.EventsReq{ToHeight: 10}), 3)
+		require.Len(t, getEvents(types.EventsReq{EventType: u64Type, FromHeight: 10, ToHeight: 10}), 2)
+		require.Empty(t, getEvents(types.EventsReq{FromHeight: 12}))
+	}
+
+	// ok: pagination
+	{
+		page1 := getEvents(types.EventsReq{EventType: u64Type, Page: sdk.NewUint(1), Limit: sdk.NewUint(3)})
+		page2 := getEvents(types.EventsReq{EventType: u64Type, Page: sdk.NewUint(2), Limit: sdk.NewUint(3)})
+		require.Len(t, page1, 3)
+		require.Len(t, page2, 1)
+		require.Equal(t, `{"Value":4}`, page2[0].DataJSON)
+	}
+
+	// fail: invalid heights range
+	{
+		_, err := input.vk.GetEvents(types.EventsReq{FromHeight: 11, ToHeight: 10, Page: sdk.OneUint(), Limit: sdk.OneUint()})
+		require.Error(t, err)
+	}
+
+	// fail: querier invalid pagination
+	{
+		querier := NewQuerier(input.vk)
+
+		for _, req := range []types.EventsReq{
+			{Limit: sdk.OneUint()},
+			{Page: sdk.OneUint()},
+			{Page: sdk.ZeroUint(), Limit: sdk.OneUint()},
+			{Page: sdk.OneUint(), Limit: sdk.NewUint(types.QueryMaxLimit + 1)},
+			{Page: sdk.NewUint(math.MaxUint64), Limit: sdk.NewUint(2)},
+		} {
+			reqBz, err := types.ModuleCdc.MarshalJSON(req)
+			require.NoError(t, err)
+
+			_, err = querier(input.ctx, []string{types.QueryEvents}, abci.RequestQuery{Data: reqBz})
+			require.True(t, sdkErrors.ErrInvalidRequest.Is(err), "request %v: %v", req, err)
+		}
+	}
+}
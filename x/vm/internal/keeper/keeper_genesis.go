@@ -8,7 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
 
+	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
@@ -19,6 +21,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 	var state types.GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(data, &state)
 
+	params := types.DefaultParams()
+	if state.Parameters != nil {
+		params = *state.Parameters
+	}
+	k.setParams(ctx, params)
+
 	for genWOIdx, genWriteOp := range state.WriteSet {
 		accessPath, value, err := genWriteOp.ToBytes()
 		if err != nil {
@@ -28,6 +36,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		k.setValue(ctx, accessPath, value)
 	}
 
+	for _, moduleInfo := range state.Modules {
+		k.setModuleInfo(ctx, moduleInfo)
+	}
+
 	// raise flag for DS server that genesis was inited
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyGenesisInit, []byte{0x1})
@@ -37,8 +49,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	k.modulePerms.AutoCheck(types.PermStorageRead)
 
+	state := k.exportWriteSet(ctx, types.WriteSetFilter{})
+	params := k.GetParams(ctx)
+	state.Parameters = &params
+
+	return k.cdc.MustMarshalJSON(state)
+}
+
+// ExportWriteSet exports VM writeSets filtered by addresses / path prefixes in the genesis state format.
+// Published modules registry entries are exported if module bytecode path matches the filter.
+func (k Keeper) ExportWriteSet(ctx sdk.Context, filter types.WriteSetFilter) types.GenesisState {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	return k.exportWriteSet(ctx, filter)
+}
+
+// exportWriteSet implements ExportWriteSet without permissions check.
+func (k Keeper) exportWriteSet(ctx sdk.Context, filter types.WriteSetFilter) types.GenesisState {
 	state := types.GenesisState{}
 	k.iterateOverValues(ctx, func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+		if !filter.Match(accessPath) {
+			return true
+		}
+
 		writeSetOp := types.GenesisWriteOp{
 			Address: hex.EncodeToString(accessPath.Address),
 			Path:    hex.EncodeToString(accessPath.Path),
@@ -49,5 +82,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 		return true
 	})
 
-	return k.cdc.MustMarshalJSON(state)
+	modules := make(types.ModuleInfos, 0)
+	for _, module := range k.getModuleInfos(ctx, types.ModuleInfoPrefix) {
+		address, err := hex.DecodeString(module.Address)
+		if err != nil {
+			panic(fmt.Errorf("module %s::%s: address: %w", module.Address, module.Name, err))
+		}
+
+		var moduleAddress [common_vm.VMAddressLength]byte
+		copy(moduleAddress[:], address)
+		modulePath := &vm_grpc.VMAccessPath{
+			Address: address,
+			Path:    glav.ModuleAccessVector(moduleAddress, module.Name),
+		}
+
+		if filter.Match(modulePath) {
+			modules = append(modules, module)
+		}
+	}
+	if len(modules) > 0 {
+		state.Modules = modules
+	}
+
+	return state
 }
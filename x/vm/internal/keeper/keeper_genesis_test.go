@@ -3,8 +3,12 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/common_vm"
@@ -62,3 +66,80 @@ func TestVMKeeper_Genesis(t *testing.T) {
 		}
 	}
 }
+
+func TestVMKeeper_GenesisRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// source state: stdlib writeSets, account resources and published module
+	srcInput := newTestInput(false)
+	defer srcInput.Stop()
+
+	var initState types.GenesisState
+	srcInput.cdc.MustUnmarshalJSON(getGenesis(t), &initState)
+	srcInput.vk.InitGenesis(srcInput.ctx, srcInput.cdc.MustMarshalJSON(initState))
+
+	accAddr := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	vmAddr := common_vm.Bech32ToLibra(accAddr)
+	var moduleAddr [common_vm.VMAddressLength]byte
+	copy(moduleAddr[:], vmAddr)
+
+	modulePath := &vm_grpc.VMAccessPath{Address: vmAddr, Path: glav.ModuleAccessVector(moduleAddr, "Foo")}
+	resourcePath := &vm_grpc.VMAccessPath{Address: vmAddr, Path: append([]byte{0x1}, randomValue(31)...)}
+	srcInput.vk.setValue(srcInput.ctx, modulePath, randomValue(64))
+	srcInput.vk.setValue(srcInput.ctx, resourcePath, randomValue(16))
+	srcInput.vk.setModuleInfo(srcInput.ctx, types.ModuleInfo{
+		Address:   hex.EncodeToString(vmAddr),
+		Name:      "Foo",
+		Publisher: accAddr,
+		Height:    10,
+		CodeHash:  hex.EncodeToString(randomValue(32)),
+		Source:    "https://github.com/me/foo",
+	})
+
+	exportBz := srcInput.vk.ExportGenesis(srcInput.ctx)
+
+	// export -> import -> export
+	{
+		var exportState types.GenesisState
+		srcInput.cdc.MustUnmarshalJSON(exportBz, &exportState)
+		require.NoError(t, exportState.Validate())
+		require.Len(t, exportState.Modules, 1)
+
+		dstInput := newTestInput(false)
+		defer dstInput.Stop()
+
+		dstInput.vk.InitGenesis(dstInput.ctx, exportBz)
+		require.Equal(t, string(exportBz), string(dstInput.vk.ExportGenesis(dstInput.ctx)))
+	}
+
+	// filter by address
+	{
+		state := srcInput.vk.ExportWriteSet(srcInput.ctx, types.WriteSetFilter{Addresses: [][]byte{vmAddr}})
+		require.Len(t, state.WriteSet, 2)
+		require.Len(t, state.Modules, 1)
+		for _, writeOp := range state.WriteSet {
+			require.Equal(t, hex.EncodeToString(vmAddr), writeOp.Address)
+		}
+	}
+
+	// filter by address and path prefix
+	{
+		state := srcInput.vk.ExportWriteSet(srcInput.ctx, types.WriteSetFilter{
+			Addresses:    [][]byte{vmAddr},
+			PathPrefixes: [][]byte{{0x1}},
+		})
+		require.Len(t, state.WriteSet, 1)
+		require.Equal(t, hex.EncodeToString(resourcePath.Path), state.WriteSet[0].Path)
+		require.Empty(t, state.Modules)
+	}
+
+	// filter by stdlib address
+	{
+		state := srcInput.vk.ExportWriteSet(srcInput.ctx, types.WriteSetFilter{Addresses: [][]byte{common_vm.StdLibAddress}})
+		require.NotEmpty(t, state.WriteSet)
+		require.Empty(t, state.Modules)
+		for _, writeOp := range state.WriteSet {
+			require.Equal(t, hex.EncodeToString(common_vm.StdLibAddress), writeOp.Address)
+		}
+	}
+}
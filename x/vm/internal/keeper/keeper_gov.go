@@ -33,6 +33,48 @@ func (k Keeper) RemoveProposalFromQueue(ctx sdk.Context, id uint64) {
 	store.Delete(queueKey)
 }
 
+// GetScheduledProposal returns gov proposal queue item by ID.
+func (k Keeper) GetScheduledProposal(ctx sdk.Context, id uint64) (types.PlannedProposal, error) {
+	k.modulePerms.AutoCheck(types.PermInit)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProposalQueueKey(id))
+	if bz == nil {
+		return nil, sdkErrors.Wrapf(types.ErrNotFound, "scheduled proposal %d", id)
+	}
+
+	return k.unmarshalPlannedProposal(bz)
+}
+
+// GetScheduledProposals returns all gov proposal queue items.
+func (k Keeper) GetScheduledProposals(ctx sdk.Context) types.ScheduledProposals {
+	k.modulePerms.AutoCheck(types.PermInit)
+
+	proposals := make(types.ScheduledProposals, 0)
+	k.IterateProposalsQueue(ctx, func(id uint64, pProposal types.PlannedProposal) {
+		proposals = append(proposals, types.ScheduledProposal{
+			ID:       id,
+			Type:     pProposal.ProposalType(),
+			Plan:     pProposal.GetPlan(),
+			Proposal: pProposal,
+		})
+	})
+
+	return proposals
+}
+
+// CancelScheduledProposal checks proposal exists and removes it from the gov proposal queue.
+func (k Keeper) CancelScheduledProposal(ctx sdk.Context, id uint64) error {
+	k.modulePerms.AutoCheck(types.PermInit)
+
+	if _, err := k.GetScheduledProposal(ctx, id); err != nil {
+		return err
+	}
+	k.RemoveProposalFromQueue(ctx, id)
+
+	return nil
+}
+
 // IterateProposalsQueue iterates over gov proposal queue.
 func (k Keeper) IterateProposalsQueue(ctx sdk.Context, handler func(id uint64, pProposal types.PlannedProposal)) {
 	k.modulePerms.AutoCheck(types.PermInit)
@@ -92,17 +134,17 @@ func (k Keeper) proposalQueueIterator(ctx sdk.Context) sdk.Iterator {
 	return store.Iterator(types.ProposalQueuePrefix, sdk.PrefixEndBytes(types.ProposalQueuePrefix))
 }
 
-// unmarshalPlannedProposal unmarshals stored PlannedProposal to a known concrete type.
+// unmarshalPlannedProposal unmarshals stored PlannedProposal checking it is a known concrete type.
 func (k Keeper) unmarshalPlannedProposal(bz []byte) (types.PlannedProposal, error) {
-	pStdlib := types.StdlibUpdateProposal{}
-	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &pStdlib); err == nil {
-		return pStdlib, nil
+	var pProposal types.PlannedProposal
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &pProposal); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "stored PlannedProposal unmarshal: %v", err)
 	}
 
-	pTest := types.TestProposal{}
-	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &pTest); err == nil {
-		return pTest, nil
+	switch pProposal.(type) {
+	case types.StdlibUpdateProposal, types.ModulePublishProposal, types.TestProposal:
+		return pProposal, nil
+	default:
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "unknown stored PlannedProposal type: %T", pProposal)
 	}
-
-	return nil, sdkErrors.Wrap(types.ErrInternal, "unkown stored PlannedProposal type")
 }
@@ -5,6 +5,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/vm/internal/types"
@@ -93,3 +94,57 @@ func TestVMKeeper_GovProposalQueue(t *testing.T) {
 
 	}
 }
+
+func TestVMKeeper_GovScheduledProposals(t *testing.T) {
+	input := newTestInput(true)
+	defer input.Stop()
+
+	testProposal := types.NewTestProposal(100, 150)
+	publishProposal := types.NewModulePublishProposal(
+		types.NewPlan(200),
+		sdk.AccAddress(input.addressBytes),
+		"http://github.com/repo",
+		"tst",
+		[]types.Contract{{1, 2, 3}},
+	).(types.ModulePublishProposal)
+
+	require.NoError(t, input.vk.ScheduleProposal(input.ctx, testProposal))
+	require.NoError(t, input.vk.ScheduleProposal(input.ctx, publishProposal))
+
+	// check listing
+	{
+		proposals := input.vk.GetScheduledProposals(input.ctx)
+		require.Len(t, proposals, 2)
+
+		require.EqualValues(t, 0, proposals[0].ID)
+		require.Equal(t, testProposal.ProposalType(), proposals[0].Type)
+		require.Equal(t, testProposal.GetPlan(), proposals[0].Plan)
+
+		require.EqualValues(t, 1, proposals[1].ID)
+		require.Equal(t, types.ProposalTypeModulePublish, proposals[1].Type)
+		require.Equal(t, publishProposal.GetPlan(), proposals[1].Plan)
+	}
+
+	// check single proposal unmarshal
+	{
+		rcvProposal, err := input.vk.GetScheduledProposal(input.ctx, 1)
+		require.NoError(t, err)
+
+		rcvPublish, ok := rcvProposal.(types.ModulePublishProposal)
+		require.True(t, ok, "type assert")
+		require.Equal(t, publishProposal, rcvPublish)
+
+		_, err = input.vk.GetScheduledProposal(input.ctx, 2)
+		require.Error(t, err)
+	}
+
+	// check cancel
+	{
+		require.NoError(t, input.vk.CancelScheduledProposal(input.ctx, 0))
+		require.Error(t, input.vk.CancelScheduledProposal(input.ctx, 0))
+
+		proposals := input.vk.GetScheduledProposals(input.ctx)
+		require.Len(t, proposals, 1)
+		require.EqualValues(t, 1, proposals[0].ID)
+	}
+}
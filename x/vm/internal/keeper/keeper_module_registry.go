@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// GetModuleInfo returns published module registry entry.
+func (k Keeper) GetModuleInfo(ctx sdk.Context, address []byte, name string) (types.ModuleInfo, error) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetModuleInfoKey(address, name))
+	if bz == nil {
+		return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrNotFound, "module 0x%s::%s", hex.EncodeToString(address), name)
+	}
+
+	info := types.ModuleInfo{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+
+	return info, nil
+}
+
+// GetModuleInfos returns published modules registry entries for the VM address.
+func (k Keeper) GetModuleInfos(ctx sdk.Context, address []byte) types.ModuleInfos {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	return k.getModuleInfos(ctx, types.GetModuleInfoAddressPrefix(address))
+}
+
+// getModuleInfos returns published modules registry entries with the storage key prefix.
+func (k Keeper) getModuleInfos(ctx sdk.Context, prefix []byte) types.ModuleInfos {
+	infos := make(types.ModuleInfos, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		info := types.ModuleInfo{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// setModuleInfo sets published module registry entry.
+func (k Keeper) setModuleInfo(ctx sdk.Context, info types.ModuleInfo) {
+	address, err := hex.DecodeString(info.Address)
+	if err != nil {
+		panic(fmt.Errorf("module info address %q: %w", info.Address, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetModuleInfoKey(address, info.Name), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// registerModules adds published modules found in the execution writeSet to the registry.
+// Module ABI is requested from DVM: request failure is handled as the VM crash (the same way as a publish request failure).
+func (k Keeper) registerModules(ctx sdk.Context, publisher sdk.AccAddress, source string, exec *vm_grpc.VMExecuteResponse) {
+	if exec.GetStatus().GetError() != nil {
+		return
+	}
+
+	for _, value := range exec.WriteSet {
+		if value.Type != vm_grpc.VmWriteOp_Value || len(value.Path.Path) == 0 || value.Path.Path[0] != glav.ModuleTag {
+			continue
+		}
+
+		meta, err := k.retryModuleMetadataReq(ctx, value.Value)
+		if err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("grpc error: %s", err.Error()))
+			panic(sdkErrors.Wrap(types.ErrVMCrashed, err.Error()))
+		}
+
+		k.setModuleInfo(ctx, types.ModuleInfo{
+			Address:   hex.EncodeToString(value.Path.Address),
+			Name:      meta.Name,
+			Publisher: publisher,
+			Height:    ctx.BlockHeight(),
+			CodeHash:  types.HashWriteSetValue(value.Value),
+			Source:    source,
+			ABI:       types.NewModuleABI(meta),
+		})
+	}
+}
+
+// getModuleMetadata requests module bytecode metadata from DVM (non-consensus request, fails fast while DVM is unavailable).
+func (k Keeper) getModuleMetadata(code []byte) (*metadata_grpc.ModuleMeta, error) {
+	if err := k.checkVMAvailability(); err != nil {
+		return nil, err
+	}
+
+	connCtx, connCancel := context.Background(), context.CancelFunc(func() {})
+	if k.config.QueryReqTimeoutInMs > 0 {
+		connCtx, connCancel = context.WithTimeout(connCtx, time.Duration(k.config.QueryReqTimeoutInMs)*time.Millisecond)
+	}
+	defer connCancel()
+
+	return k.doModuleMetadataReq(connCtx, code)
+}
+
+// retryModuleMetadataReq requests module bytecode metadata from DVM with retry mechanism (consensus request).
+// Only connection-level failures are retried.
+func (k Keeper) retryModuleMetadataReq(ctx sdk.Context, code []byte) (retMeta *metadata_grpc.ModuleMeta, retErr error) {
+	var reqErr error
+	retErr = k.retryReq(ctx, context.Background(), k.config.MaxAttempts, k.config.ReqTimeoutInMs, func(connCtx context.Context) error {
+		var err error
+		retMeta, err = k.doModuleMetadataReq(connCtx, code)
+		if err != nil && !isConnectionError(err) {
+			reqErr = err
+			return nil
+		}
+
+		return err
+	})
+	if retErr == nil {
+		retErr = reqErr
+	}
+
+	return
+}
+
+// doModuleMetadataReq sends module bytecode metadata request.
+func (k Keeper) doModuleMetadataReq(connCtx context.Context, code []byte) (*metadata_grpc.ModuleMeta, error) {
+	meta, err := k.client.GetMetadata(connCtx, &metadata_grpc.Bytecode{Code: code})
+	if err != nil {
+		return nil, fmt.Errorf("getting module metadata: %w", err)
+	}
+	if meta.GetModule() == nil {
+		return nil, fmt.Errorf("getting module metadata: not a module")
+	}
+
+	return meta.GetModule(), nil
+}
@@ -0,0 +1,75 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test published modules registry with mocked VM.
+func TestVMKeeper_ModuleRegistryMock(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+
+	acc := sdk.AccAddress(randomValue(20))
+	vmAddr := common_vm.Bech32ToLibra(acc)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	codeBytes, err := hex.DecodeString(moveCode)
+	require.NoError(t, err)
+
+	// ok: deploy two modules
+	msg := types.NewMsgDeployModule(acc, []types.Contract{codeBytes, codeBytes})
+	msg.Source = "https://example.com/module.move"
+	require.NoError(t, input.vk.DeployContract(ctx, msg))
+
+	// ok: list
+	infos := input.vk.GetModuleInfos(ctx, vmAddr)
+	require.Len(t, infos, 2)
+	for _, info := range infos {
+		require.NoError(t, info.Validate())
+		require.Equal(t, hex.EncodeToString(vmAddr), info.Address)
+		require.Equal(t, acc, info.Publisher)
+		require.EqualValues(t, 5, info.Height)
+		require.Equal(t, msg.Source, info.Source)
+		require.Len(t, info.CodeHash, 64)
+		require.Len(t, info.ABI.Structs, 1)
+		require.Len(t, info.ABI.Functions, 1)
+		require.Equal(t, "public fun get(address): u64", info.ABI.Functions[0].String())
+
+		// ok: get
+		getInfo, err := input.vk.GetModuleInfo(ctx, vmAddr, info.Name)
+		require.NoError(t, err)
+		require.Equal(t, info, getInfo)
+	}
+
+	// fail: not found
+	{
+		_, err := input.vk.GetModuleInfo(ctx, vmAddr, "Unknown")
+		require.True(t, types.ErrNotFound.Is(err))
+		require.Empty(t, input.vk.GetModuleInfos(ctx, randomValue(20)))
+	}
+
+	// ok: genesis export / import
+	{
+		var state types.GenesisState
+		input.cdc.MustUnmarshalJSON(input.vk.ExportGenesis(ctx), &state)
+		require.Len(t, state.Modules, 2)
+		require.NoError(t, state.Validate())
+
+		newInput := newTestInput(false)
+		defer newInput.Stop()
+
+		newInput.vk.InitGenesis(newInput.ctx, input.cdc.MustMarshalJSON(state))
+		require.Equal(t, infos, newInput.vk.GetModuleInfos(newInput.ctx, vmAddr))
+	}
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// GetResources returns VM modules and resources stored under the {address} with pagination.
+// Resource struct tags are decoded using modules metadata of the stdlib and the {address} (if possible).
+func (k Keeper) GetResources(ctx sdk.Context, params types.ResourcesReq) (types.Resources, error) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	if err := params.Validate(); err != nil {
+		return types.Resources{}, fmt.Errorf("request: %w", err)
+	}
+
+	startIdx := params.Page.SubUint64(1).Mul(params.Limit).Uint64()
+	endIdx := startIdx + params.Limit.Uint64()
+
+	resp := types.Resources{
+		Items: make([]types.Resource, 0),
+	}
+	var pageValues [][]byte
+	k.iterateOverAddressValues(ctx, params.Address, func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+		// total counting is bounded: stop iterating once the page is built and the limit is reached
+		if resp.Total >= endIdx && resp.Total >= types.ResourcesMaxTotal {
+			resp.TotalCapped = true
+			return false
+		}
+
+		if resp.Total >= startIdx && resp.Total < endIdx {
+			item := types.Resource{
+				Path: hex.EncodeToString(accessPath.Path),
+				Type: types.ResourceTypeResource,
+				Size: len(value),
+			}
+			if accessPath.Path[0] == glav.ModuleTag {
+				item.Type = types.ResourceTypeModule
+			}
+
+			resp.Items = append(resp.Items, item)
+			pageValues = append(pageValues, value)
+		}
+		resp.Total++
+
+		return true
+	})
+
+	// decode names
+	resolver := newResourceNameResolver(ctx, k)
+	for i := range resp.Items {
+		item := &resp.Items[i]
+		if item.Type == types.ResourceTypeModule {
+			item.Name = resolver.ModuleName(params.Address, pageValues[i])
+		} else {
+			item.Name = resolver.ResourceName(params.Address, item.Path)
+		}
+	}
+
+	return resp, nil
+}
+
+// iterateOverAddressValues iterates over VM values of the {address} and processes them with handler (stop when handler returns false).
+func (k Keeper) iterateOverAddressValues(ctx sdk.Context, address []byte, handler func(accessPath *vm_grpc.VMAccessPath, value []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, common_vm.GetAddressPathPrefixKey(address))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		accessPath := common_vm.MustParsePathKey(iterator.Key())
+		value := iterator.Value()
+
+		if !handler(accessPath, value) {
+			break
+		}
+	}
+}
+
+// resourceNameResolver decodes VM paths to Move names.
+// As resource VM path is a struct tag hash, resolver builds known struct tags dictionary using modules metadata:
+//   - non-generic structs of stdlib and account modules;
+//   - single type param generic structs instantiated with non-generic structs (0x1::Account::Balance<0x1::Coins::ETH> for example);
+type resourceNameResolver struct {
+	ctx         sdk.Context
+	keeper      Keeper
+	structNames map[string]map[string]string         // key: account address, value: (key: VM path HEX, value: struct tag)
+	modules     map[string]*metadata_grpc.ModuleMeta // key: module bytecode
+}
+
+func newResourceNameResolver(ctx sdk.Context, k Keeper) *resourceNameResolver {
+	return &resourceNameResolver{
+		ctx:         ctx,
+		keeper:      k,
+		structNames: make(map[string]map[string]string),
+		modules:     make(map[string]*metadata_grpc.ModuleMeta),
+	}
+}
+
+// ModuleName returns module name using its bytecode metadata (empty if failed).
+func (r *resourceNameResolver) ModuleName(address, code []byte) string {
+	meta := r.getModuleMeta(code)
+	if meta == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("0x%s::%s", hex.EncodeToString(address), meta.Name)
+}
+
+// ResourceName returns resource struct tag (empty if unknown).
+func (r *resourceNameResolver) ResourceName(address []byte, pathHex string) string {
+	names, ok := r.structNames[string(address)]
+	if !ok {
+		names = r.buildStructNames(address)
+		r.structNames[string(address)] = names
+	}
+
+	return names[pathHex]
+}
+
+// buildStructNames builds known struct tags dictionary for the {address}.
+func (r *resourceNameResolver) buildStructNames(address []byte) map[string]string {
+	var plainTypes, genericTypes []types.MoveType
+
+	addresses := [][]byte{common_vm.StdLibAddress}
+	if string(address) != string(common_vm.StdLibAddress) {
+		addresses = append(addresses, address)
+	}
+
+	for _, moduleAddress := range addresses {
+		r.keeper.iterateOverAddressValues(r.ctx, moduleAddress, func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+			if accessPath.Path[0] != glav.ModuleTag {
+				return true
+			}
+
+			meta := r.getModuleMeta(value)
+			if meta == nil {
+				return true
+			}
+
+			for _, structMeta := range meta.Types {
+				if structMeta == nil {
+					continue
+				}
+
+				moveType := types.MoveType{Kind: types.ViewerTypeStruct, Address: moduleAddress, Module: meta.Name, Name: structMeta.Name}
+				switch len(structMeta.TypeParameters) {
+				case 0:
+					plainTypes = append(plainTypes, moveType)
+				case 1:
+					genericTypes = append(genericTypes, moveType)
+				}
+			}
+
+			return true
+		})
+	}
+
+	names := make(map[string]string)
+	addName := func(moveType types.MoveType) {
+		tag, err := moveType.StructTag()
+		if err != nil {
+			return
+		}
+		names[hex.EncodeToString(tag.AccessVector())] = moveType.String()
+	}
+
+	for _, plainType := range plainTypes {
+		addName(plainType)
+	}
+	for _, genericType := range genericTypes {
+		for _, plainType := range plainTypes {
+			genericType.TypeArgs = []types.MoveType{plainType}
+			addName(genericType)
+		}
+	}
+
+	return names
+}
+
+// getModuleMeta requests module metadata from DVM (nil if failed).
+func (r *resourceNameResolver) getModuleMeta(code []byte) *metadata_grpc.ModuleMeta {
+	if meta, ok := r.modules[string(code)]; ok {
+		return meta
+	}
+
+	meta, err := r.keeper.getModuleMetadata(code)
+	if err != nil {
+		r.keeper.GetLogger(r.ctx).Debug(fmt.Sprintf("resources: %v", err))
+		r.modules[string(code)] = nil
+		return nil
+	}
+	r.modules[string(code)] = meta
+
+	return meta
+}
@@ -0,0 +1,196 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test account resources listing with pagination and names decoding.
+func TestVMKeeper_GetResources(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	var stdAddr, accAddr, otherAddr [20]byte
+	copy(stdAddr[:], common_vm.StdLibAddress)
+	accAddr[0], otherAddr[0] = 0x2, 0x3
+
+	stdModuleCode, accModuleCode := []byte{0x1}, []byte{0x2}
+	input.vk.client = mockMetadataClient{modules: map[string]*metadata_grpc.ModuleMeta{
+		string(stdModuleCode): {
+			Name: "Coins",
+			Types: []*metadata_grpc.Struct{
+				{Name: "ETH"},
+				{Name: "Balance", TypeParameters: []string{"T"}},
+			},
+		},
+		string(accModuleCode): {
+			Name: "Foo",
+			Types: []*metadata_grpc.Struct{
+				{Name: "Bar"},
+			},
+		},
+	}}
+
+	setValue := func(address [20]byte, path, value []byte) {
+		input.vk.SetValue(input.ctx, &vm_grpc.VMAccessPath{Address: address[:], Path: path}, value)
+	}
+
+	ethType := types.MoveType{Kind: types.ViewerTypeStruct, Address: stdAddr[:], Module: "Coins", Name: "ETH"}
+	barType := types.MoveType{Kind: types.ViewerTypeStruct, Address: accAddr[:], Module: "Foo", Name: "Bar"}
+	balanceType := types.MoveType{Kind: types.ViewerTypeStruct, Address: stdAddr[:], Module: "Coins", Name: "Balance", TypeArgs: []types.MoveType{ethType}}
+	balanceTag, err := balanceType.StructTag()
+	require.NoError(t, err)
+	barTag, err := barType.StructTag()
+	require.NoError(t, err)
+	unknownPath := append([]byte{glav.ResourceTag}, randomValue(32)...)
+
+	setValue(stdAddr, glav.ModuleAccessVector(stdAddr, "Coins"), stdModuleCode)
+	setValue(accAddr, glav.ModuleAccessVector(accAddr, "Foo"), accModuleCode)
+	setValue(accAddr, balanceTag.AccessVector(), randomValue(8))
+	setValue(accAddr, barTag.AccessVector(), randomValue(4))
+	setValue(accAddr, unknownPath, randomValue(4))
+	setValue(otherAddr, barTag.AccessVector(), randomValue(4))
+
+	getResources := func(page, limit uint64) types.Resources {
+		resources, err := input.vk.GetResources(input.ctx, types.ResourcesReq{
+			Address: accAddr[:],
+			Page:    sdk.NewUint(page),
+			Limit:   sdk.NewUint(limit),
+		})
+		require.NoError(t, err)
+
+		return resources
+	}
+
+	// ok: all
+	{
+		resources := getResources(1, 10)
+		require.EqualValues(t, 4, resources.Total)
+		require.Len(t, resources.Items, 4)
+
+		names := make(map[string]types.Resource)
+		for _, item := range resources.Items {
+			names[item.Path] = item
+		}
+
+		moduleItem := names[hex.EncodeToString(glav.ModuleAccessVector(accAddr, "Foo"))]
+		require.Equal(t, types.ResourceTypeModule, moduleItem.Type)
+		require.Equal(t, "0x"+hex.EncodeToString(accAddr[:])+"::Foo", moduleItem.Name)
+
+		balanceItem := names[hex.EncodeToString(balanceTag.AccessVector())]
+		require.Equal(t, types.ResourceTypeResource, balanceItem.Type)
+		require.Equal(t, balanceType.String(), balanceItem.Name)
+		require.Equal(t, 8, balanceItem.Size)
+
+		require.Equal(t, barType.String(), names[hex.EncodeToString(barTag.AccessVector())].Name)
+		require.Empty(t, names[hex.EncodeToString(unknownPath)].Name)
+	}
+
+	// ok: pagination
+	{
+		page1, page2, page3 := getResources(1, 3), getResources(2, 3), getResources(3, 3)
+		require.Len(t, page1.Items, 3)
+		require.Len(t, page2.Items, 1)
+		require.Len(t, page3.Items, 0)
+		require.EqualValues(t, 4, page3.Total)
+
+		require.Equal(t, getResources(1, 10).Items, append(page1.Items, page2.Items...))
+	}
+
+	// fail: invalid address
+	{
+		_, err := input.vk.GetResources(input.ctx, types.ResourcesReq{Address: []byte{0x1}, Page: sdk.OneUint(), Limit: sdk.OneUint()})
+		require.Error(t, err)
+	}
+
+	// fail: querier invalid pagination
+	{
+		querier := NewQuerier(input.vk)
+		maxUint64 := sdk.NewUint(math.MaxUint64)
+
+		for _, req := range []types.ResourcesReq{
+			{Address: accAddr[:], Limit: sdk.OneUint()},
+			{Address: accAddr[:], Page: sdk.OneUint()},
+			{Address: accAddr[:], Page: sdk.ZeroUint(), Limit: sdk.OneUint()},
+			{Address: accAddr[:], Page: sdk.OneUint(), Limit: sdk.ZeroUint()},
+			{Address: accAddr[:], Page: sdk.OneUint(), Limit: sdk.NewUint(types.QueryMaxLimit + 1)},
+			{Address: accAddr[:], Page: maxUint64, Limit: sdk.NewUint(types.QueryMaxLimit)},
+			{Address: accAddr[:], Page: maxUint64.Mul(maxUint64), Limit: sdk.OneUint()},
+		} {
+			reqBz, err := types.ModuleCdc.MarshalJSON(req)
+			require.NoError(t, err)
+
+			_, err = querier(input.ctx, []string{types.QueryResources}, abci.RequestQuery{Data: reqBz})
+			require.True(t, sdkErrors.ErrInvalidRequest.Is(err), "request %v: %v", req, err)
+		}
+	}
+}
+
+// Test account resources total counting is bounded.
+func TestVMKeeper_GetResourcesTotalCapped(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	ctx := input.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	var accAddr [20]byte
+	accAddr[0] = 0x2
+
+	for i := 0; i < types.ResourcesMaxTotal+10; i++ {
+		path := append([]byte{glav.ResourceTag}, randomValue(32)...)
+		input.vk.SetValue(ctx, &vm_grpc.VMAccessPath{Address: accAddr[:], Path: path}, []byte{0x1})
+	}
+
+	getResources := func(page, limit uint64) types.Resources {
+		resources, err := input.vk.GetResources(ctx, types.ResourcesReq{
+			Address: accAddr[:],
+			Page:    sdk.NewUint(page),
+			Limit:   sdk.NewUint(limit),
+		})
+		require.NoError(t, err)
+
+		return resources
+	}
+
+	// ok: first page
+	{
+		resources := getResources(1, 10)
+		require.Len(t, resources.Items, 10)
+		require.True(t, resources.TotalCapped)
+		require.EqualValues(t, types.ResourcesMaxTotal, resources.Total)
+	}
+
+	// ok: page beyond the limit
+	{
+		resources := getResources(types.ResourcesMaxTotal/5+1, 5)
+		require.Len(t, resources.Items, 5)
+		require.True(t, resources.TotalCapped)
+		require.EqualValues(t, types.ResourcesMaxTotal+5, resources.Total)
+	}
+
+	// ok: last page
+	{
+		resources := getResources(types.ResourcesMaxTotal/5+2, 5)
+		require.Len(t, resources.Items, 5)
+		require.False(t, resources.TotalCapped)
+		require.EqualValues(t, types.ResourcesMaxTotal+10, resources.Total)
+	}
+}
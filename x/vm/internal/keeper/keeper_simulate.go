@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// SimulateExecuteScript executes Move script against a cached context and returns execution results (state is not changed).
+func (k Keeper) SimulateExecuteScript(ctx sdk.Context, msg types.MsgExecuteScript) (retResp types.SimulateResp, retErr error) {
+	k.modulePerms.AutoCheck(types.PermVmExec)
+
+	// gas consumption might panic
+	defer func() {
+		if r := recover(); r != nil {
+			retResp, retErr = types.SimulateResp{}, newSimulationPanicError(r)
+		}
+	}()
+
+	params := k.GetParams(ctx)
+	simCtx := newSimulateContext(ctx, params)
+
+	req, sdkErr := NewExecuteRequest(simCtx, params, msg)
+	if sdkErr != nil {
+		return types.SimulateResp{}, sdkErr
+	}
+
+	exec, err := k.sendExecuteReq(simCtx, nil, req)
+	if err != nil {
+		return types.SimulateResp{}, wrapVMReqError(err)
+	}
+
+	return k.processSimulation(simCtx, exec), nil
+}
+
+// SimulateDeployContract deploys Move module(s) against a cached context and returns execution results (state is not changed).
+// Modules are processed one by one, so a module can depend on the previous ones within the same message.
+func (k Keeper) SimulateDeployContract(ctx sdk.Context, msg types.MsgDeployModule) (retResp types.SimulateResp, retErr error) {
+	k.modulePerms.AutoCheck(types.PermVmExec)
+
+	// gas consumption might panic
+	defer func() {
+		if r := recover(); r != nil {
+			retResp, retErr = types.SimulateResp{}, newSimulationPanicError(r)
+		}
+	}()
+
+	params := k.GetParams(ctx)
+	simCtx := newSimulateContext(ctx, params)
+
+	resp := newSimulateResp(len(msg.Module))
+	for _, contract := range msg.Module {
+		simCtx.GasMeter().ConsumeGas(params.GetPublishGas(len(contract)), "vm module publish")
+		req := NewDeployRequest(simCtx, params, msg.Signer, contract)
+
+		exec, err := k.sendExecuteReq(simCtx, req, nil)
+		if err != nil {
+			return types.SimulateResp{}, wrapVMReqError(err)
+		}
+		k.processSimulationExec(simCtx, &resp, exec)
+	}
+
+	return completeSimulateResp(simCtx, resp), nil
+}
+
+// processSimulation processes VM execution results building writeSets diff.
+func (k Keeper) processSimulation(ctx sdk.Context, execList ...*vm_grpc.VMExecuteResponse) types.SimulateResp {
+	resp := newSimulateResp(len(execList))
+	for _, exec := range execList {
+		k.processSimulationExec(ctx, &resp, exec)
+	}
+
+	return completeSimulateResp(ctx, resp)
+}
+
+// processSimulationExec processes a single VM execution result appending its status and writeSets diff to the {resp}.
+func (k Keeper) processSimulationExec(ctx sdk.Context, resp *types.SimulateResp, exec *vm_grpc.VMExecuteResponse) {
+	resp.VMStatuses = append(resp.VMStatuses, types.NewVMStatusFromExecStatus(exec.Status))
+
+	if exec.GetStatus().GetError() == nil {
+		for _, value := range exec.WriteSet {
+			resp.WriteSet = append(resp.WriteSet, k.newWriteSetDiff(ctx, value))
+		}
+	}
+
+	k.processExecution(ctx, exec)
+}
+
+// newSimulateResp creates an empty simulation response.
+func newSimulateResp(execCount int) types.SimulateResp {
+	return types.SimulateResp{
+		VMStatuses: make(types.VMStatuses, 0, execCount),
+		WriteSet:   make(types.WriteSetDiffs, 0),
+	}
+}
+
+// completeSimulateResp sets simulation gas used and events.
+func completeSimulateResp(ctx sdk.Context, resp types.SimulateResp) types.SimulateResp {
+	resp.GasUsed = ctx.GasMeter().GasConsumed()
+	resp.Events = sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+
+	return resp
+}
+
+// newWriteSetDiff builds writeSet operation diff using the current storage value (storage read gas is not consumed).
+func (k Keeper) newWriteSetDiff(ctx sdk.Context, value *vm_grpc.VMValue) types.WriteSetDiff {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	diff := types.WriteSetDiff{
+		Op:      types.WriteSetOpSet,
+		Address: hex.EncodeToString(value.Path.Address),
+		Path:    hex.EncodeToString(value.Path.Path),
+	}
+
+	if k.hasValue(ctx, value.Path) {
+		diff.OldValue = hex.EncodeToString(k.getValue(ctx, value.Path))
+	}
+
+	if value.Type == vm_grpc.VmWriteOp_Deletion {
+		diff.Op = types.WriteSetOpDelete
+	} else {
+		diff.NewValue = hex.EncodeToString(value.Value)
+	}
+
+	return diff
+}
+
+// newSimulateContext creates cached context for simulation with gas limit defined by the VM MaxGas param.
+func newSimulateContext(ctx sdk.Context, params types.Params) sdk.Context {
+	simCtx, _ := ctx.CacheContext()
+
+	return WithDSContextKind(simCtx, DSContextSimulate).
+		WithGasMeter(sdk.NewGasMeter(params.GetSDKGas(params.MaxGas))).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// newSimulationPanicError converts simulation panic (out of gas, gas overflow) to an error.
+func newSimulationPanicError(r interface{}) error {
+	switch rType := r.(type) {
+	case sdk.ErrorOutOfGas:
+		return sdkErrors.Wrapf(sdkErrors.ErrOutOfGas, "simulation out of gas in location: %v", rType.Descriptor)
+	case sdk.ErrorGasOverflow:
+		return sdkErrors.Wrapf(sdkErrors.ErrOutOfGas, "simulation gas overflow in location: %v", rType.Descriptor)
+	default:
+		return sdkErrors.Wrapf(types.ErrInternal, "simulation panic: %v", r)
+	}
+}
+
+// wrapVMReqError wraps VM request error with ErrVMCrashed keeping the retryable ErrVMUnavailable error as is.
+func wrapVMReqError(err error) error {
+	if types.ErrVMUnavailable.Is(err) {
+		return err
+	}
+
+	return sdkErrors.Wrap(types.ErrVMCrashed, err.Error())
+}
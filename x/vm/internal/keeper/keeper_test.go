@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/vm/internal/types"
@@ -79,3 +81,79 @@ func TestVMKeeper_ExecuteScriptMock(t *testing.T) {
 	require.EqualValues(t, types.AttributeStatus, events[1].Attributes[0].Key)
 	require.EqualValues(t, types.AttributeValueStatusKeep, events[1].Attributes[0].Value)
 }
+
+// Simulate script execution and module deploy with mocked VM.
+func TestVMKeeper_SimulateMock(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+
+	acc := sdk.AccAddress(randomValue(20))
+
+	codeBytes, err := hex.DecodeString(moveCode)
+	require.NoError(t, err)
+
+	checkNotPersisted := func(resp types.SimulateResp) {
+		for _, diff := range resp.WriteSet {
+			address, err := hex.DecodeString(diff.Address)
+			require.NoError(t, err)
+			path, err := hex.DecodeString(diff.Path)
+			require.NoError(t, err)
+
+			require.False(t, input.vk.HasValue(input.ctx, &vm_grpc.VMAccessPath{Address: address, Path: path}))
+		}
+		require.Empty(t, input.ctx.EventManager().Events())
+	}
+
+	// ok: script
+	{
+		resp, err := input.vk.SimulateExecuteScript(input.ctx, types.NewMsgExecuteScript(acc, codeBytes, nil))
+		require.NoError(t, err)
+
+		require.GreaterOrEqual(t, resp.GasUsed, uint64(10000))
+		require.Len(t, resp.VMStatuses, 1)
+		require.Equal(t, types.AttributeValueStatusKeep, resp.VMStatuses[0].Status)
+		require.Len(t, resp.WriteSet, 2)
+		for _, diff := range resp.WriteSet {
+			require.Equal(t, types.WriteSetOpSet, diff.Op)
+			require.Empty(t, diff.OldValue)
+			require.NotEmpty(t, diff.NewValue)
+		}
+		eventTypes := make([]string, 0, len(resp.Events))
+		for _, event := range resp.Events {
+			eventTypes = append(eventTypes, event.Type)
+		}
+		require.ElementsMatch(t, []string{sdk.EventTypeMessage, types.EventTypeContractStatus, types.EventTypeMoveEvent}, eventTypes)
+
+		checkNotPersisted(resp)
+	}
+
+	// ok: module
+	{
+		resp, err := input.vk.SimulateDeployContract(input.ctx, types.NewMsgDeployModule(acc, []types.Contract{codeBytes, codeBytes}))
+		require.NoError(t, err)
+
+		require.GreaterOrEqual(t, resp.GasUsed, uint64(20000))
+		require.Len(t, resp.VMStatuses, 2)
+		require.Len(t, resp.WriteSet, 2)
+
+		checkNotPersisted(resp)
+	}
+
+	// fail: out of gas (gas limit is defined by the MaxGas param)
+	{
+		ctx, _ := input.ctx.CacheContext()
+		params := input.vk.GetParams(ctx)
+		params.MaxGas = 5000
+		input.vk.setParams(ctx, params)
+
+		_, err := input.vk.SimulateExecuteScript(ctx, types.NewMsgExecuteScript(acc, codeBytes, nil))
+		require.Error(t, err)
+		require.True(t, sdkErrors.ErrOutOfGas.Is(err))
+
+		_, err = input.vk.SimulateDeployContract(ctx, types.NewMsgDeployModule(acc, []types.Contract{codeBytes}))
+		require.Error(t, err)
+		require.True(t, sdkErrors.ErrOutOfGas.Is(err))
+	}
+}
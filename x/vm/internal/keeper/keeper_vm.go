@@ -78,6 +78,7 @@ func (k Keeper) setValue(ctx sdk.Context, accessPath *vm_grpc.VMAccessPath, valu
 	key := common_vm.GetPathKey(accessPath)
 
 	store.Set(key, value)
+	k.dsServer.invalidateCache(key)
 }
 
 // delValue removes value from VM storage by key.
@@ -86,12 +87,13 @@ func (k Keeper) delValue(ctx sdk.Context, accessPath *vm_grpc.VMAccessPath) {
 	key := common_vm.GetPathKey(accessPath)
 
 	store.Delete(key)
+	k.dsServer.invalidateCache(key)
 }
 
 // processExecution processes VM execution result (emit events, convert VM events, update writeSets).
 func (k Keeper) processExecution(ctx sdk.Context, exec *vm_grpc.VMExecuteResponse) {
 	// consume gas, if execution took too much gas - panic and mark transaction as out of gas
-	ctx.GasMeter().ConsumeGas(exec.GasUsed, "vm script/module execution")
+	ctx.GasMeter().ConsumeGas(k.GetParams(ctx).GetSDKGas(exec.GasUsed), "vm script/module execution")
 
 	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
 	ctx.EventManager().EmitEvents(types.NewContractEvents(exec))
@@ -100,15 +102,15 @@ func (k Keeper) processExecution(ctx sdk.Context, exec *vm_grpc.VMExecuteRespons
 	if exec.GetStatus().GetError() == nil {
 		k.processWriteSet(ctx, exec.WriteSet)
 
-		// emit VM events (panic on "out of gas", emitted events stays in the EventManager)
-		for _, vmEvent := range exec.Events {
-			ctx.EventManager().EmitEvent(types.NewMoveEvent(ctx.GasMeter(), vmEvent))
-		}
+		// emit VM events
+		k.processEvents(ctx, exec.Events)
 	}
 }
 
 // processWriteSet processes VM execution writeSets (set/delete).
 func (k Keeper) processWriteSet(ctx sdk.Context, writeSet []*vm_grpc.VMValue) {
+	k.indexWriteSet(ctx, writeSet)
+
 	for _, value := range writeSet {
 		// check type and solve what to do.
 		if value.Type == vm_grpc.VmWriteOp_Deletion {
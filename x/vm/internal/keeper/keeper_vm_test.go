@@ -11,8 +11,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
 
+	"github.com/dfinance/dnode/helpers/tests/mockdvm"
 	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
@@ -392,3 +395,119 @@ func TestVMKeeper_ExecKeepAndError(t *testing.T) {
 	require.EqualValues(t, types.AttributeErrMessage, events[1].Attributes[3].Key)
 	require.EqualValues(t, errMessage, events[1].Attributes[3].Value)
 }
+
+// Test script execution / module publishing with the in-process mockDVM backend.
+func TestVMKeeper_MockDVMBackend(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	mockDVM := mockdvm.NewMockDVM()
+	var _ VMClient = mockDVM.Client()
+	input.vk.client = mockDVM.Client()
+
+	signer := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	vmAddr := common_vm.Bech32ToLibra(signer)
+
+	// ok: configured module publish (writeSet, module metadata)
+	{
+		moduleCode := []byte{0x1, 0x2}
+		var moduleAddr [common_vm.VMAddressLength]byte
+		copy(moduleAddr[:], vmAddr)
+		modulePath := &vm_grpc.VMAccessPath{Address: vmAddr, Path: glav.ModuleAccessVector(moduleAddr, "Foo")}
+
+		mockDVM.SetExecResult(moduleCode, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: modulePath, Value: moduleCode}}, nil)
+		mockDVM.SetModuleMetadata(moduleCode, &metadata_grpc.ModuleMeta{Name: "Foo"})
+
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, input.vk.DeployContract(ctx, types.NewMsgDeployModule(signer, []types.Contract{moduleCode})))
+
+		require.Equal(t, moduleCode, input.vk.getValue(ctx, modulePath))
+		info, err := input.vk.GetModuleInfo(ctx, vmAddr, "Foo")
+		require.NoError(t, err)
+		require.Equal(t, signer, info.Publisher)
+	}
+
+	// ok: configured script execution (writeSet, events)
+	{
+		scriptCode := []byte{0x3, 0x4}
+		valuePath := randomPath()
+		event := &vm_grpc.VMEvent{
+			SenderAddress: vmAddr,
+			EventType:     &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU64},
+			EventData:     []byte{1, 0, 0, 0, 0, 0, 0, 0},
+		}
+
+		mockDVM.SetExecResult(scriptCode, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: valuePath, Value: []byte{0x5}}}, []*vm_grpc.VMEvent{event})
+
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, input.vk.ExecuteScript(ctx, types.NewMsgExecuteScript(signer, scriptCode, nil)))
+
+		require.Equal(t, []byte{0x5}, input.vk.getValue(ctx, valuePath))
+		contractEvents := 0
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypeMoveEvent {
+				contractEvents++
+			}
+		}
+		require.Equal(t, 1, contractEvents)
+	}
+
+	// ok: not configured script execution is aborted (no writeSet)
+	{
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, input.vk.ExecuteScript(ctx, types.NewMsgExecuteScript(signer, []byte{0x6}, nil)))
+
+		resp, err := input.vk.ExecuteScriptNoProcessing(ctx, types.NewMsgExecuteScript(signer, []byte{0x6}, nil))
+		require.NoError(t, err)
+		require.NotNil(t, resp.GetStatus().GetError())
+		require.Empty(t, resp.WriteSet)
+	}
+
+	// fail: DVM is unavailable (DeliverTx request fails after configured attempts)
+	{
+		input.vk.config.MaxAttempts = 1
+		mockDVM.SetUnavailable()
+
+		_, err := input.vk.ExecuteScriptNoProcessing(input.ctx, types.NewMsgExecuteScript(signer, []byte{0x6}, nil))
+		require.Error(t, err)
+	}
+}
+
+func TestVMKeeper_DeployContractDryRun(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	mockDVM := mockdvm.NewMockDVM()
+	input.vk.client = mockDVM.Client()
+
+	signer := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	vmAddr := common_vm.Bech32ToLibra(signer)
+
+	var moduleAddr [common_vm.VMAddressLength]byte
+	copy(moduleAddr[:], vmAddr)
+	fooCode, fooPath := []byte{0x1, 0x2}, &vm_grpc.VMAccessPath{Address: vmAddr, Path: glav.ModuleAccessVector(moduleAddr, "Foo")}
+	barCode, barPath := []byte{0x3, 0x4}, &vm_grpc.VMAccessPath{Address: vmAddr, Path: glav.ModuleAccessVector(moduleAddr, "Bar")}
+	mockDVM.SetExecResult(fooCode, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: fooPath, Value: fooCode}}, nil)
+	mockDVM.SetExecResult(barCode, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: barPath, Value: barCode}}, nil)
+
+	// ok: writeSets are not persisted
+	{
+		msg := types.NewMsgDeployModule(signer, []types.Contract{fooCode, barCode})
+		require.NoError(t, input.vk.DeployContractDryRun(input.ctx, msg))
+
+		require.False(t, input.vk.hasValue(input.ctx, fooPath))
+		require.False(t, input.vk.hasValue(input.ctx, barPath))
+	}
+
+	// fail: not configured module publish is aborted
+	{
+		msg := types.NewMsgDeployModule(signer, []types.Contract{fooCode, {0x5}})
+		require.Error(t, input.vk.DeployContractDryRun(input.ctx, msg))
+
+		require.False(t, input.vk.hasValue(input.ctx, fooPath))
+	}
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/dfinance/dnode/cmd/config"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+const (
+	writeSetIndexDBName = "vm_writeset_index"
+)
+
+// GetTxWriteSet returns indexed transaction writeSets summary.
+func (k Keeper) GetTxWriteSet(txHash []byte) (types.TxWriteSet, error) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	if k.writeSetIndex == nil {
+		return types.TxWriteSet{}, sdkErrors.Wrap(types.ErrIndexDisabled, "writeSets")
+	}
+
+	bz, err := k.writeSetIndex.Get(txHash)
+	if err != nil {
+		return types.TxWriteSet{}, sdkErrors.Wrapf(types.ErrInternal, "indexer DB read: %v", err)
+	}
+	if bz == nil {
+		return types.TxWriteSet{}, sdkErrors.Wrapf(types.ErrNotFound, "tx %s: writeSets not indexed", hex.EncodeToString(txHash))
+	}
+
+	var writeSet types.TxWriteSet
+	k.cdc.MustUnmarshalBinaryBare(bz, &writeSet)
+
+	return writeSet, nil
+}
+
+// FlushWriteSetIndex moves the current block transactions writeSets summaries from the transient storage to the indexer DB.
+func (k Keeper) FlushWriteSetIndex(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermStorageWrite)
+
+	if k.writeSetIndex == nil {
+		return
+	}
+
+	store := k.getIndexStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.TxWriteSetPrefix)
+	defer iterator.Close()
+
+	batch := k.writeSetIndex.NewBatch()
+	defer batch.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		txHash := iterator.Key()[len(types.TxWriteSetPrefix):]
+
+		var items []types.WriteSetIndexItem
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &items)
+
+		writeSet := types.TxWriteSet{
+			TxHash: hex.EncodeToString(txHash),
+			Height: ctx.BlockHeight(),
+			Items:  items,
+		}
+		batch.Set(txHash, k.cdc.MustMarshalBinaryBare(writeSet))
+	}
+
+	if err := batch.Write(); err != nil {
+		k.GetLogger(ctx).Error(fmt.Sprintf("writeSets indexer DB write: %v", err))
+	}
+}
+
+// indexWriteSet appends transaction writeSets summary to the transient storage.
+// Only deliver transactions are indexed, failed transactions are dropped with the transient storage cache.
+func (k Keeper) indexWriteSet(ctx sdk.Context, writeSet []*vm_grpc.VMValue) {
+	if k.writeSetIndex == nil || len(ctx.TxBytes()) == 0 || GetDSContextKind(ctx) != DSContextDeliver {
+		return
+	}
+
+	store := k.getIndexStore(ctx)
+	key := types.GetTxWriteSetKey(tmhash.Sum(ctx.TxBytes()))
+
+	var items []types.WriteSetIndexItem
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &items)
+	}
+
+	for _, value := range writeSet {
+		items = append(items, types.NewWriteSetIndexItem(value))
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(items))
+}
+
+// getIndexStore returns transient storage without gas consumption (indexer must not affect the consensus).
+func (k Keeper) getIndexStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(k.tStoreKey)
+}
+
+// newWriteSetIndexDB opens writeSets indexer DB if enabled.
+func newWriteSetIndexDB(config *config.VMConfig) dbm.DB {
+	if config == nil || !config.WriteSetIndexEnabled {
+		return nil
+	}
+
+	db, err := dbm.NewGoLevelDB(writeSetIndexDBName, config.WriteSetIndexDir)
+	if err != nil {
+		panic(fmt.Errorf("opening VM writeSets indexer DB at %q: %w", config.WriteSetIndexDir, err))
+	}
+
+	return db
+}
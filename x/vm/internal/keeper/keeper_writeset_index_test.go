@@ -0,0 +1,93 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test writeSets indexer: transient storage buffering, flush and query.
+func TestVMKeeper_WriteSetIndex(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	// ok: disabled
+	{
+		_, err := input.vk.GetTxWriteSet([]byte{1})
+		require.True(t, types.ErrIndexDisabled.Is(err))
+	}
+
+	input.vk.writeSetIndex = dbm.NewMemDB()
+
+	path1, path2 := randomPath(), randomPath()
+	value1, value2 := randomValue(16), randomValue(16)
+	txBytes := randomValue(64)
+	txHash := tmhash.Sum(txBytes)
+	ctx := input.ctx.WithBlockHeight(10).WithTxBytes(txBytes)
+
+	// ok: non-tx and simulate contexts are not indexed
+	{
+		input.vk.processWriteSet(input.ctx, []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: path1, Value: value1}})
+		simCtx, _ := ctx.CacheContext()
+		input.vk.processWriteSet(WithDSContextKind(simCtx, DSContextSimulate), []*vm_grpc.VMValue{{Type: vm_grpc.VmWriteOp_Value, Path: path1, Value: value1}})
+
+		input.vk.FlushWriteSetIndex(ctx)
+		_, err := input.vk.GetTxWriteSet(txHash)
+		require.True(t, types.ErrNotFound.Is(err))
+	}
+
+	// ok: multiple executions within one tx
+	{
+		gasBefore := ctx.GasMeter().GasConsumed()
+		input.vk.processWriteSet(ctx, []*vm_grpc.VMValue{
+			{Type: vm_grpc.VmWriteOp_Value, Path: path1, Value: value1},
+		})
+		input.vk.processWriteSet(ctx, []*vm_grpc.VMValue{
+			{Type: vm_grpc.VmWriteOp_Value, Path: path2, Value: value2},
+			{Type: vm_grpc.VmWriteOp_Deletion, Path: path1},
+		})
+		gasWithIndex := ctx.GasMeter().GasConsumed() - gasBefore
+
+		input.vk.FlushWriteSetIndex(ctx)
+
+		writeSet, err := input.vk.GetTxWriteSet(txHash)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(txHash), writeSet.TxHash)
+		require.EqualValues(t, 10, writeSet.Height)
+		require.Len(t, writeSet.Items, 3)
+
+		require.Equal(t, types.WriteSetOpSet, writeSet.Items[0].Op)
+		require.Equal(t, hex.EncodeToString(path1.Address), writeSet.Items[0].Address)
+		require.Equal(t, hex.EncodeToString(path1.Path), writeSet.Items[0].Path)
+		require.Equal(t, types.HashWriteSetValue(value1), writeSet.Items[0].ValueHash)
+
+		require.Equal(t, types.WriteSetOpSet, writeSet.Items[1].Op)
+		require.Equal(t, types.HashWriteSetValue(value2), writeSet.Items[1].ValueHash)
+
+		require.Equal(t, types.WriteSetOpDelete, writeSet.Items[2].Op)
+		require.Empty(t, writeSet.Items[2].ValueHash)
+
+		// indexer doesn't consume gas
+		input.vk.writeSetIndex = nil
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		input.vk.processWriteSet(gasCtx, []*vm_grpc.VMValue{
+			{Type: vm_grpc.VmWriteOp_Value, Path: path1, Value: value1},
+		})
+		input.vk.processWriteSet(gasCtx, []*vm_grpc.VMValue{
+			{Type: vm_grpc.VmWriteOp_Value, Path: path2, Value: value2},
+			{Type: vm_grpc.VmWriteOp_Deletion, Path: path1},
+		})
+		require.Equal(t, gasWithIndex, gasCtx.GasMeter().GasConsumed())
+	}
+}
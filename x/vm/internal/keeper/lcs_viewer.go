@@ -1,17 +1,25 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
 	"strings"
+	"unicode/utf8"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dfinance/lcs"
 
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
+const (
+	lcsU256Length = 32
+)
+
 func StringifyLCSData(request types.ViewerRequest, lscData []byte) (string, error) {
 	structType, err := getStructReflectType(request, "")
 	if err != nil {
@@ -23,7 +31,12 @@ func StringifyLCSData(request types.ViewerRequest, lscData []byte) (string, erro
 		return "", fmt.Errorf("LCS unmarshal: %w", err)
 	}
 
-	output, err := json.MarshalIndent(structPtr.Interface(), "", "  ")
+	outputObj, err := renderStructValue(request, structPtr.Elem())
+	if err != nil {
+		return "", fmt.Errorf("rendering result: %w", err)
+	}
+
+	output, err := json.MarshalIndent(outputObj, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("result JSON marshal: %w", err)
 	}
@@ -47,7 +60,7 @@ func getStructReflectType(request types.ViewerRequest, rootFieldName string) (re
 			return nil, buildErr(item, "empty struct field name")
 		}
 
-		fieldName := strings.Title(strings.ToLower(item.Name))
+		fieldName := getViewerFieldName(item)
 		fieldType, err := getFieldReflectType(item)
 		if err != nil {
 			return nil, buildErr(item, err.Error())
@@ -61,16 +74,26 @@ func getStructReflectType(request types.ViewerRequest, rootFieldName string) (re
 }
 
 func getFieldReflectType(item types.ViewerItem) (reflect.Type, error) {
+	if err := item.ValidateFormat(); err != nil {
+		return nil, err
+	}
+
 	switch item.Type {
 	case types.ViewerTypeU8:
 		return reflect.TypeOf(uint8(0)), nil
+	case types.ViewerTypeU16:
+		return reflect.TypeOf(uint16(0)), nil
+	case types.ViewerTypeU32:
+		return reflect.TypeOf(uint32(0)), nil
 	case types.ViewerTypeU64:
 		return reflect.TypeOf(uint64(0)), nil
 	case types.ViewerTypeU128:
 		return reflect.TypeOf(&big.Int{}), nil
+	case types.ViewerTypeU256:
+		return reflect.TypeOf([lcsU256Length]uint8{}), nil
 	case types.ViewerTypeBool:
 		return reflect.TypeOf(false), nil
-	case types.ViewerTypeAddress:
+	case types.ViewerTypeAddress, types.ViewerTypeSigner:
 		return reflect.TypeOf([20]uint8{}), nil
 	case types.ViewerTypeStruct:
 		if item.InnerItem == nil {
@@ -78,7 +101,7 @@ func getFieldReflectType(item types.ViewerItem) (reflect.Type, error) {
 		}
 
 		return getStructReflectType(*item.InnerItem, item.Name)
-	case types.ViewerTypeVector:
+	case types.ViewerTypeVector, types.ViewerTypeOption:
 		if item.InnerItem == nil {
 			return nil, fmt.Errorf("inner_item: nil")
 		}
@@ -91,8 +114,120 @@ func getFieldReflectType(item types.ViewerItem) (reflect.Type, error) {
 			return nil, fmt.Errorf("inner_item[0]: %w", err)
 		}
 
+		if item.Type == types.ViewerTypeOption {
+			// Move Option<T> is a struct with vector<T> field containing zero or one element
+			return reflect.StructOf([]reflect.StructField{{Name: "Vec", Type: reflect.SliceOf(sliceType)}}), nil
+		}
+
 		return reflect.SliceOf(sliceType), nil
 	}
 
 	return nil, fmt.Errorf("unknown field type %q", item.Type)
 }
+
+// getViewerFieldName returns output field name for viewer item.
+func getViewerFieldName(item types.ViewerItem) string {
+	return strings.Title(strings.ToLower(item.Name))
+}
+
+// renderStructValue converts decoded struct value to the ordered JSON object.
+func renderStructValue(request types.ViewerRequest, value reflect.Value) (lcsViewObject, error) {
+	obj := make(lcsViewObject, 0, len(request))
+	for i, item := range request {
+		fieldValue, err := renderFieldValue(item, value.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", item.Name, err)
+		}
+
+		obj = append(obj, lcsViewObjectField{Name: getViewerFieldName(item), Value: fieldValue})
+	}
+
+	return obj, nil
+}
+
+// renderFieldValue converts decoded field value to JSON marshal friendly object according to the item type and format.
+func renderFieldValue(item types.ViewerItem, value reflect.Value) (interface{}, error) {
+	switch item.Type {
+	case types.ViewerTypeU256:
+		leBytes := value.Interface().([lcsU256Length]uint8)
+		return lcs.LeToBig(leBytes[:]), nil
+	case types.ViewerTypeAddress, types.ViewerTypeSigner:
+		address := value.Interface().([20]uint8)
+		switch item.Format {
+		case types.ViewerFormatHex:
+			return "0x" + hex.EncodeToString(address[:]), nil
+		case types.ViewerFormatBech32:
+			return sdk.AccAddress(address[:]).String(), nil
+		}
+		return address, nil
+	case types.ViewerTypeStruct:
+		return renderStructValue(*item.InnerItem, value)
+	case types.ViewerTypeOption:
+		vec := value.Field(0)
+		if vec.Len() == 0 {
+			return nil, nil
+		}
+		return renderFieldValue((*item.InnerItem)[0], vec.Index(0))
+	case types.ViewerTypeVector:
+		if item.IsBytes() {
+			bz := value.Bytes()
+			switch item.Format {
+			case types.ViewerFormatHex:
+				return hex.EncodeToString(bz), nil
+			case types.ViewerFormatString:
+				if !utf8.Valid(bz) {
+					return nil, fmt.Errorf("invalid UTF-8 string")
+				}
+				return string(bz), nil
+			}
+			return bz, nil
+		}
+
+		elems := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elem, err := renderFieldValue((*item.InnerItem)[0], value.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			elems = append(elems, elem)
+		}
+		return elems, nil
+	}
+
+	return value.Interface(), nil
+}
+
+// lcsViewObject is a JSON object with ordered fields (order matches the viewer request).
+type lcsViewObject []lcsViewObjectField
+
+type lcsViewObjectField struct {
+	Name  string
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (obj lcsViewObject) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteString("{")
+	for i, field := range obj {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		nameBz, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		valueBz, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(nameBz)
+		buf.WriteString(":")
+		buf.Write(valueBz)
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+const (
+	// Max nested structs depth for the metadata based viewer request
+	viewerMetaMaxDepth = 16
+)
+
+// BuildViewerRequest builds LCS viewer request for {structType} using module metadata received from DVM.
+// Generic struct type arguments must be defined.
+func (k Keeper) BuildViewerRequest(ctx sdk.Context, structType types.MoveType) (types.ViewerRequest, error) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	b := viewerMetaBuilder{
+		keeper:  k,
+		ctx:     ctx,
+		modules: make(map[string]*metadata_grpc.ModuleMeta),
+	}
+
+	return b.buildStruct(structType, 0)
+}
+
+// viewerMetaBuilder builds viewer request recursively caching modules metadata.
+type viewerMetaBuilder struct {
+	keeper  Keeper
+	ctx     sdk.Context
+	modules map[string]*metadata_grpc.ModuleMeta
+}
+
+func (b viewerMetaBuilder) buildStruct(structType types.MoveType, depth int) (types.ViewerRequest, error) {
+	if depth > viewerMetaMaxDepth {
+		return nil, fmt.Errorf("%s: max nested structs depth reached", structType.String())
+	}
+
+	structMeta, err := b.getStructMeta(structType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(structMeta.TypeParameters) != len(structType.TypeArgs) {
+		return nil, fmt.Errorf("%s: type arguments count mismatch: expected %d", structType.String(), len(structMeta.TypeParameters))
+	}
+	// type parameters are resolved by the declared names only (field types reference them)
+	typeArgs := make(map[string]types.MoveType, len(structType.TypeArgs))
+	for i, typeArg := range structType.TypeArgs {
+		paramName := structMeta.TypeParameters[i]
+		if _, ok := typeArgs[paramName]; ok {
+			return nil, fmt.Errorf("%s: type parameter %q: duplicated", structType.String(), paramName)
+		}
+		typeArgs[paramName] = typeArg
+	}
+
+	request := make(types.ViewerRequest, 0, len(structMeta.Field))
+	for _, field := range structMeta.Field {
+		fieldType, err := types.ParseMoveType(field.Type, structType.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%s: field %q: %w", structType.String(), field.Name, err)
+		}
+
+		item, err := b.buildItem(field.Name, fieldType, typeArgs, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: field %q: %w", structType.String(), field.Name, err)
+		}
+		request = append(request, item)
+	}
+
+	return request, nil
+}
+
+func (b viewerMetaBuilder) buildItem(name string, itemType types.MoveType, typeArgs map[string]types.MoveType, depth int) (types.ViewerItem, error) {
+	if itemType.IsParam() {
+		typeArg, ok := typeArgs[itemType.Name]
+		if !ok {
+			return types.ViewerItem{}, fmt.Errorf("type parameter %q: not defined", itemType.Name)
+		}
+		itemType = typeArg
+	}
+
+	item := types.ViewerItem{Name: name, Type: itemType.Kind}
+	switch {
+	case itemType.Kind == types.ViewerTypeVector:
+		elemItem, err := b.buildItem(name, itemType.TypeArgs[0], typeArgs, depth)
+		if err != nil {
+			return types.ViewerItem{}, err
+		}
+		item.InnerItem = &types.ViewerRequest{elemItem}
+	case itemType.IsOption():
+		if len(itemType.TypeArgs) != 1 {
+			return types.ViewerItem{}, fmt.Errorf("%s: one type argument expected", itemType.String())
+		}
+
+		elemItem, err := b.buildItem(name, itemType.TypeArgs[0], typeArgs, depth)
+		if err != nil {
+			return types.ViewerItem{}, err
+		}
+		item.Type = types.ViewerTypeOption
+		item.InnerItem = &types.ViewerRequest{elemItem}
+	case itemType.Kind == types.ViewerTypeStruct:
+		// type arguments of the nested struct might reference current struct type parameters
+		resolvedType, err := resolveMoveTypeParams(itemType, typeArgs)
+		if err != nil {
+			return types.ViewerItem{}, err
+		}
+
+		innerRequest, err := b.buildStruct(resolvedType, depth+1)
+		if err != nil {
+			return types.ViewerItem{}, err
+		}
+		item.InnerItem = &innerRequest
+	}
+
+	return item, nil
+}
+
+// getStructMeta requests module metadata from DVM and searches for the struct.
+func (b viewerMetaBuilder) getStructMeta(structType types.MoveType) (*metadata_grpc.Struct, error) {
+	var address [20]byte
+	copy(address[:], structType.Address)
+
+	moduleKey := string(address[:]) + structType.Module
+	moduleMeta, ok := b.modules[moduleKey]
+	if !ok {
+		code := b.keeper.GetValue(b.ctx, &vm_grpc.VMAccessPath{
+			Address: address[:],
+			Path:    glav.ModuleAccessVector(address, structType.Module),
+		})
+		if code == nil {
+			return nil, fmt.Errorf("%s: module not found", structType.String())
+		}
+
+		var err error
+		moduleMeta, err = b.keeper.getModuleMetadata(code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", structType.String(), err)
+		}
+		b.modules[moduleKey] = moduleMeta
+	}
+
+	for _, structMeta := range moduleMeta.Types {
+		if structMeta != nil && structMeta.Name == structType.Name {
+			return structMeta, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: struct not found in module metadata", structType.String())
+}
+
+// resolveMoveTypeParams substitutes type parameters in {t} type arguments.
+func resolveMoveTypeParams(t types.MoveType, typeArgs map[string]types.MoveType) (types.MoveType, error) {
+	if t.IsParam() {
+		typeArg, ok := typeArgs[t.Name]
+		if !ok {
+			return types.MoveType{}, fmt.Errorf("type parameter %q: not defined", t.Name)
+		}
+		return typeArg, nil
+	}
+
+	if len(t.TypeArgs) == 0 {
+		return t, nil
+	}
+
+	resolved := t
+	resolved.TypeArgs = make([]types.MoveType, 0, len(t.TypeArgs))
+	for _, arg := range t.TypeArgs {
+		resolvedArg, err := resolveMoveTypeParams(arg, typeArgs)
+		if err != nil {
+			return types.MoveType{}, err
+		}
+		resolved.TypeArgs = append(resolved.TypeArgs, resolvedArg)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,220 @@
+// +build unit
+
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// mockMetadataClient returns module metadata by module bytecode (other VMClient requests are not supported).
+type mockMetadataClient struct {
+	VMClient
+	modules map[string]*metadata_grpc.ModuleMeta
+}
+
+func (c mockMetadataClient) GetMetadata(ctx context.Context, in *metadata_grpc.Bytecode, opts ...grpc.CallOption) (*metadata_grpc.Metadata, error) {
+	meta, ok := c.modules[string(in.Code)]
+	if !ok {
+		return nil, fmt.Errorf("module not found")
+	}
+
+	return &metadata_grpc.Metadata{Meta: &metadata_grpc.Metadata_Module{Module: meta}}, nil
+}
+
+// Test StringifyLCSData with extended types and formats.
+func TestVMKeeper_StringifyLCSData(t *testing.T) {
+	type option struct {
+		Vec []uint64
+	}
+	type inner struct {
+		Flag bool
+	}
+	data := struct {
+		A16   uint16
+		A32   uint32
+		A128  *big.Int
+		A256  [32]uint8
+		Addr  [20]uint8
+		Bytes []uint8
+		Str   []uint8
+		Some  option
+		None  option
+		Inner []inner
+	}{
+		A16:   1,
+		A32:   2,
+		A128:  big.NewInt(3),
+		Addr:  [20]uint8{19: 0x1},
+		Bytes: []uint8{0xAB, 0xCD},
+		Str:   []uint8("hello"),
+		Some:  option{Vec: []uint64{5}},
+		Inner: []inner{{Flag: true}},
+	}
+	data.A256[0] = 4
+	bz, err := lcs.Marshal(data)
+	require.NoError(t, err)
+
+	u8Item := types.ViewerItem{Name: "b", Type: types.ViewerTypeU8}
+	u64Item := types.ViewerItem{Name: "v", Type: types.ViewerTypeU64}
+	request := types.ViewerRequest{
+		{Name: "a16", Type: types.ViewerTypeU16},
+		{Name: "a32", Type: types.ViewerTypeU32},
+		{Name: "a128", Type: types.ViewerTypeU128},
+		{Name: "a256", Type: types.ViewerTypeU256},
+		{Name: "addr", Type: types.ViewerTypeAddress},
+		{Name: "bytes", Type: types.ViewerTypeVector, InnerItem: &types.ViewerRequest{u8Item}},
+		{Name: "str", Type: types.ViewerTypeVector, Format: types.ViewerFormatString, InnerItem: &types.ViewerRequest{u8Item}},
+		{Name: "some", Type: types.ViewerTypeOption, InnerItem: &types.ViewerRequest{u64Item}},
+		{Name: "none", Type: types.ViewerTypeOption, InnerItem: &types.ViewerRequest{u64Item}},
+		{Name: "inner", Type: types.ViewerTypeVector, InnerItem: &types.ViewerRequest{
+			{Name: "inner", Type: types.ViewerTypeStruct, InnerItem: &types.ViewerRequest{{Name: "flag", Type: types.ViewerTypeBool}}},
+		}},
+	}
+
+	// ok: default formats
+	{
+		output, err := StringifyLCSData(request, bz)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"A16": 1, "A32": 2, "A128": 3, "A256": 4,
+			"Addr": [0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1],
+			"Bytes": "q80=", "Str": "hello",
+			"Some": 5, "None": null,
+			"Inner": [{"Flag": true}]
+		}`, output)
+	}
+
+	// ok: hex / bech32 formats
+	{
+		output, err := StringifyLCSData(request.WithDefaultFormats(types.ViewerFormatHex, types.ViewerFormatBech32), bz)
+		require.NoError(t, err)
+
+		res := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(output), &res))
+		require.Equal(t, sdk.AccAddress(data.Addr[:]).String(), res["Addr"])
+		require.Equal(t, "abcd", res["Bytes"])
+		require.Equal(t, "hello", res["Str"])
+	}
+
+	// ok: fields order is preserved
+	{
+		output, err := StringifyLCSData(types.ViewerRequest{{Name: "b", Type: types.ViewerTypeU16}, {Name: "a", Type: types.ViewerTypeU32}}, bz[:6])
+		require.NoError(t, err)
+		require.Equal(t, "{\n  \"B\": 1,\n  \"A\": 2\n}", output)
+	}
+
+	// fail: unsupported format
+	{
+		invalidRequest := types.ViewerRequest{{Name: "a16", Type: types.ViewerTypeU16, Format: types.ViewerFormatHex}}
+		_, err := StringifyLCSData(invalidRequest, bz)
+		require.Error(t, err)
+	}
+}
+
+// Test BuildViewerRequest using mocked module metadata.
+func TestVMKeeper_BuildViewerRequest(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	moduleAddr := [20]byte{19: 0x2}
+	input.vk.SetValue(input.ctx, &vm_grpc.VMAccessPath{
+		Address: moduleAddr[:],
+		Path:    glav.ModuleAccessVector(moduleAddr, "Foo"),
+	}, []byte{0x1})
+
+	input.vk.client = mockMetadataClient{modules: map[string]*metadata_grpc.ModuleMeta{
+		string([]byte{0x1}): {
+			Name: "Foo",
+			Types: []*metadata_grpc.Struct{
+				{
+					Name:           "Bar",
+					IsResource:     true,
+					TypeParameters: []string{"T"},
+					Field: []*metadata_grpc.Field{
+						{Name: "value", Type: "T"},
+						{Name: "owner", Type: "address"},
+						{Name: "data", Type: "vector<u8>"},
+						{Name: "maybe", Type: "0x1::Option::Option<u64>"},
+						{Name: "items", Type: "vector<Foo::Item<T>>"},
+					},
+				},
+				{
+					Name:           "Item",
+					TypeParameters: []string{"T"},
+					Field: []*metadata_grpc.Field{
+						{Name: "id", Type: "T"},
+					},
+				},
+				{
+					Name:           "Positional",
+					TypeParameters: []string{"T"},
+					Field: []*metadata_grpc.Field{
+						{Name: "id", Type: "T0"},
+					},
+				},
+			},
+		},
+	}}
+
+	barType, err := types.ParseMoveType("0x2::Foo::Bar<u128>", nil)
+	require.NoError(t, err)
+
+	// ok
+	{
+		request, err := input.vk.BuildViewerRequest(input.ctx, barType)
+		require.NoError(t, err)
+		require.Len(t, request, 5)
+
+		require.Equal(t, types.ViewerTypeU128, request[0].Type)
+		require.Equal(t, types.ViewerTypeAddress, request[1].Type)
+		require.True(t, request[2].IsBytes())
+		require.Equal(t, types.ViewerTypeOption, request[3].Type)
+		require.Equal(t, types.ViewerTypeU64, (*request[3].InnerItem)[0].Type)
+		require.Equal(t, types.ViewerTypeVector, request[4].Type)
+
+		itemRequest := (*request[4].InnerItem)[0]
+		require.Equal(t, types.ViewerTypeStruct, itemRequest.Type)
+		require.Equal(t, types.ViewerTypeU128, (*itemRequest.InnerItem)[0].Type)
+	}
+
+	// fail: type args mismatch
+	{
+		barType.TypeArgs = nil
+		_, err := input.vk.BuildViewerRequest(input.ctx, barType)
+		require.Error(t, err)
+	}
+
+	// fail: type parameter referenced by position (not by the declared name)
+	{
+		positionalType, err := types.ParseMoveType("0x2::Foo::Positional<u128>", nil)
+		require.NoError(t, err)
+
+		_, err = input.vk.BuildViewerRequest(input.ctx, positionalType)
+		require.Error(t, err)
+	}
+
+	// fail: module not found
+	{
+		bazType, err := types.ParseMoveType("0x3::Foo::Bar<u128>", nil)
+		require.NoError(t, err)
+
+		_, err = input.vk.BuildViewerRequest(input.ctx, bazType)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// GetParams returns keeper params (default values are used for not yet set params, param store reads don't consume gas).
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramStore.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// setParams sets keeper params.
+func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
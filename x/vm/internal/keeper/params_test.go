@@ -0,0 +1,71 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Check params setters / getters and genesis params.
+func TestVMKeeper_Params(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	// default params (not set yet)
+	require.Equal(t, types.DefaultParams(), input.vk.GetParams(input.ctx))
+
+	// set / get
+	inParams := types.NewParams(2, 10, 1000000, 5)
+	input.vk.setParams(input.ctx, inParams)
+	require.Equal(t, inParams, input.vk.GetParams(input.ctx))
+
+	// genesis import / export
+	{
+		genParams := types.NewParams(3, 2, 500000, 1)
+		input.vk.InitGenesis(input.ctx, input.cdc.MustMarshalJSON(types.GenesisState{Parameters: &genParams}))
+		require.Equal(t, genParams, input.vk.GetParams(input.ctx))
+
+		var state types.GenesisState
+		input.cdc.MustUnmarshalJSON(input.vk.ExportGenesis(input.ctx), &state)
+		require.NotNil(t, state.Parameters)
+		require.Equal(t, genParams, *state.Parameters)
+
+		// default params are set if genesis params are omitted
+		input.vk.InitGenesis(input.ctx, input.cdc.MustMarshalJSON(types.GenesisState{}))
+		require.Equal(t, types.DefaultParams(), input.vk.GetParams(input.ctx))
+	}
+}
+
+// Check VM gas used is converted to SDK gas using the gas multiplier param.
+func TestVMKeeper_ParamsGasMultiplier(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+
+	input.vk.setParams(input.ctx, types.NewParams(1, 10, types.DefaultMaxGas, 0))
+
+	resp := &vm_grpc.VMExecuteResponse{
+		GasUsed: 100,
+		Status:  &vm_grpc.VMStatus{},
+	}
+
+	ctx := input.ctx.WithGasMeter(sdk.NewGasMeter(10000)).WithEventManager(sdk.NewEventManager())
+	input.vk.processExecution(ctx, resp)
+	require.EqualValues(t, 1000, ctx.GasMeter().GasConsumed())
+
+	// out of gas
+	resp.GasUsed = 1001
+	ctx = input.ctx.WithGasMeter(sdk.NewGasMeter(10000)).WithEventManager(sdk.NewEventManager())
+	require.Panics(t, func() {
+		input.vk.processExecution(ctx, resp)
+	})
+}
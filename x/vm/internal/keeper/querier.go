@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
@@ -16,11 +17,29 @@ import (
 // NewQuerier return keeper querier.
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		ctx = WithDSContextKind(ctx, DSContextQuery)
+
 		switch path[0] {
 		case types.QueryValue:
 			return queryGetValue(ctx, k, req)
 		case types.QueryLcsView:
 			return queryLcsView(ctx, k, req)
+		case types.QuerySimulate:
+			return querySimulate(ctx, k, req)
+		case types.QueryTxWriteSet:
+			return queryTxWriteSet(k, req)
+		case types.QueryEvents:
+			return queryEvents(k, req)
+		case types.QueryResources:
+			return queryResources(ctx, k, req)
+		case types.QueryModules:
+			return queryModules(ctx, k, req)
+		case types.QueryModule:
+			return queryModule(ctx, k, req)
+		case types.QueryProposals:
+			return queryProposals(ctx, k)
+		case types.QueryParams:
+			return queryParams(ctx, k)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -52,12 +71,46 @@ func queryLcsView(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, err
 	copy(addrLibra[:], common_vm.Bech32ToLibra(request.Address)[:20])
 
 	var resPath []byte
+	var structType types.MoveType
 	if request.StructName != "" {
-		resPath = glav.NewStructTag(addrLibra, request.ModuleName, request.StructName, nil).AccessVector()
+		structType = types.MoveType{
+			Kind:    types.ViewerTypeStruct,
+			Address: addrLibra[:],
+			Module:  request.ModuleName,
+			Name:    request.StructName,
+		}
+		for _, typeParamStr := range request.TypeParams {
+			typeParam, err := types.ParseMoveType(typeParamStr, addrLibra[:])
+			if err != nil {
+				return nil, sdkErrors.Wrapf(types.ErrInternal, "type_params: %v", err)
+			}
+			structType.TypeArgs = append(structType.TypeArgs, typeParam)
+		}
+
+		structTag, err := structType.StructTag()
+		if err != nil {
+			return nil, sdkErrors.Wrapf(types.ErrInternal, "type_params: %v", err)
+		}
+		resPath = structTag.AccessVector()
 	} else {
 		resPath = glav.ModuleAccessVector(addrLibra, request.ModuleName)
 	}
 
+	// Build view request using module metadata
+	viewRequest := request.ViewRequest
+	if len(viewRequest) == 0 {
+		if request.StructName == "" {
+			return nil, sdkErrors.Wrap(types.ErrInternal, "struct_name: required to build view request from metadata")
+		}
+
+		var err error
+		viewRequest, err = k.BuildViewerRequest(ctx, structType)
+		if err != nil {
+			return nil, sdkErrors.Wrapf(types.ErrInternal, "building view request from metadata: %v", err)
+		}
+	}
+	viewRequest = viewRequest.WithDefaultFormats(request.BytesFormat, request.AddressFormat)
+
 	// Get raw writeSet data
 	resData := k.GetValueWithMiddlewares(ctx, &vm_grpc.VMAccessPath{Address: request.Address, Path: resPath})
 	if resData == nil {
@@ -65,10 +118,161 @@ func queryLcsView(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, err
 	}
 
 	// Get LCS view
-	resp, err := StringifyLCSData(request.ViewRequest, resData)
+	resp, err := StringifyLCSData(viewRequest, resData)
 	if err != nil {
 		return nil, sdkErrors.Wrap(types.ErrInternal, err.Error())
 	}
 
 	return []byte(resp), nil
 }
+
+// querySimulate handles simulate query which executes VM message against a cached context.
+func querySimulate(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.SimulateReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp types.SimulateResp
+	var err error
+	if request.ExecuteScript != nil {
+		resp, err = k.SimulateExecuteScript(ctx, *request.ExecuteScript)
+	} else {
+		resp, err = k.SimulateDeployContract(ctx, *request.DeployModule)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, resp)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryTxWriteSet handles txWriteSet query which returns indexed transaction writeSets summary.
+func queryTxWriteSet(k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.TxWriteSetReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	writeSet, err := k.GetTxWriteSet(request.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, writeSet)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryEvents handles events query which returns indexed VM events.
+func queryEvents(k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.EventsReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+	if err := request.Validate(); err != nil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "request: %v", err)
+	}
+
+	events, err := k.GetEvents(request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, events)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryResources handles resources query which returns VM modules and resources stored under the address.
+func queryResources(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.ResourcesReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+	if err := request.Validate(); err != nil {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "request: %v", err)
+	}
+
+	resources, err := k.GetResources(ctx, request)
+	if err != nil {
+		return nil, sdkErrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, resources)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryModules handles modules query which returns published modules registry entries for the address.
+func queryModules(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.ModulesReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetModuleInfos(ctx, request.Address))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryModule handles module query which returns published module registry entry (with ABI).
+func queryModule(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var request types.ModuleReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	info, err := k.GetModuleInfo(ctx, request.Address, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryProposals handles proposals query which returns the gov proposal queue.
+func queryProposals(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetScheduledProposals(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryParams handles params query request.
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "response marshal: %v", err)
+	}
+
+	return res, nil
+}
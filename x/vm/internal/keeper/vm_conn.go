@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/dfinance/dnode/cmd/config"
+)
+
+// connMonitor periodically checks DVM connection health and forces reconnects with backoff while DVM is unavailable.
+// Monitor state acts as a circuit breaker: non-consensus requests and CheckTx VM Txs fail fast while DVM is unavailable.
+type connMonitor struct {
+	conn    *grpc.ClientConn
+	client  metadata_grpc.DVMBytecodeMetadataClient
+	period  time.Duration
+	timeout time.Duration
+	backoff retryBackoff
+	// health status (0 - unavailable, 1 - available) and the last health check error
+	healthy int32
+	lastErr atomic.Value
+	//
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+}
+
+// IsHealthy checks the last health check status (DVM is considered available until the first failed check).
+func (m *connMonitor) IsHealthy() bool {
+	return atomic.LoadInt32(&m.healthy) == 1
+}
+
+// LastError returns the last failed health check error.
+func (m *connMonitor) LastError() error {
+	if err, ok := m.lastErr.Load().(error); ok {
+		return err
+	}
+
+	return fmt.Errorf("unknown")
+}
+
+// Start starts the health check worker.
+func (m *connMonitor) Start(logger log.Logger) {
+	m.startOnce.Do(func() {
+		go m.worker(logger)
+	})
+}
+
+// Stop stops the health check worker.
+func (m *connMonitor) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+	})
+}
+
+// Check pings DVM with the metadata request.
+// Any server response (even an error) is handled as healthy, only connection-level failures are not.
+func (m *connMonitor) Check() error {
+	connCtx, connCancel := context.Background(), context.CancelFunc(func() {})
+	if m.timeout > 0 {
+		connCtx, connCancel = context.WithTimeout(connCtx, m.timeout)
+	}
+	defer connCancel()
+
+	_, err := m.client.GetMetadata(connCtx, &metadata_grpc.Bytecode{})
+	if err != nil && isConnectionError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// worker checks DVM connection health with the {period}, on failure it forces reconnect and rechecks with backoff.
+func (m *connMonitor) worker(logger log.Logger) {
+	for {
+		delay := m.period
+
+		if err := m.Check(); err != nil {
+			m.lastErr.Store(err)
+			if atomic.SwapInt32(&m.healthy, 0) == 1 {
+				logger.Error(fmt.Sprintf("VM connection is unavailable: %v", err))
+			}
+
+			// skip connection backoff on the gRPC side
+			m.conn.ResetConnectBackoff()
+			if backoff := m.backoff.Next(); backoff > 0 {
+				delay = backoff
+			}
+		} else {
+			if atomic.SwapInt32(&m.healthy, 1) == 0 {
+				logger.Info("VM connection is restored")
+			}
+			m.backoff.Reset()
+		}
+
+		select {
+		case <-m.stopCh:
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// newConnMonitor creates a new connMonitor if health check is enabled.
+func newConnMonitor(conn *grpc.ClientConn, client metadata_grpc.DVMBytecodeMetadataClient, config *config.VMConfig) *connMonitor {
+	if conn == nil || config == nil || config.HealthCheckPeriodInMs == 0 {
+		return nil
+	}
+
+	return &connMonitor{
+		conn:    conn,
+		client:  client,
+		period:  time.Duration(config.HealthCheckPeriodInMs) * time.Millisecond,
+		timeout: time.Duration(config.HealthCheckTimeoutInMs) * time.Millisecond,
+		backoff: newRetryBackoff(config),
+		healthy: 1,
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// retryBackoff is an exponential delay generator used between failed VM requests / reconnects.
+type retryBackoff struct {
+	initial    time.Duration
+	max        time.Duration
+	multiplier float64
+	cur        time.Duration
+}
+
+// Next returns the current delay and increases it for the next call.
+func (b *retryBackoff) Next() time.Duration {
+	delay := b.cur
+	if delay == 0 {
+		delay = b.initial
+	}
+
+	next := time.Duration(math.Round(float64(delay) * b.multiplier))
+	if b.max > 0 && next > b.max {
+		next = b.max
+	}
+	b.cur = next
+
+	return delay
+}
+
+// Reset resets the current delay to the initial one.
+func (b *retryBackoff) Reset() {
+	b.cur = 0
+}
+
+// newRetryBackoff creates a new retryBackoff using VM config retry settings.
+func newRetryBackoff(config *config.VMConfig) retryBackoff {
+	b := retryBackoff{multiplier: 1.0}
+	if config == nil {
+		return b
+	}
+
+	b.initial = time.Duration(config.RetryInitialBackoffInMs) * time.Millisecond
+	b.max = time.Duration(config.RetryMaxBackoffInMs) * time.Millisecond
+	if config.RetryBackoffMultiplier > 1.0 {
+		b.multiplier = config.RetryBackoffMultiplier
+	}
+
+	return b
+}
+
+// isConnectionError checks if gRPC error (might be wrapped) is a connection-level failure (DVM is not reachable / not responding).
+func isConnectionError(err error) bool {
+	var grpcErr interface{ GRPCStatus() *grpcStatus.Status }
+	if !errors.As(err, &grpcErr) {
+		return false
+	}
+
+	switch grpcErr.GRPCStatus().Code() {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
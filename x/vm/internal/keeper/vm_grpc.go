@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dfinance/dvm-proto/go/compiler_grpc"
 	"github.com/dfinance/dvm-proto/go/metadata_grpc"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
@@ -14,24 +15,34 @@ import (
 )
 
 const (
-	VMMaxGasLimit = ^uint64(0)/1000 - 1
+	VMMaxGasLimit = types.VMMaxGasLimit
 )
 
-// VMClient is an aggregated gRPC VM services client.
-type VMClient struct {
-	VMCompilerClient        compiler_grpc.DvmCompilerClient
-	VMMetaDataClient        metadata_grpc.DVMBytecodeMetadataClient
-	VMModulePublisherClient vm_grpc.VMModulePublisherClient
-	VMScriptExecutorClient  vm_grpc.VMScriptExecutorClient
+// VMClient is an aggregated VM services client.
+// Default implementation uses gRPC connection to DVM, other implementations (in-process backends for tests)
+// can be plugged in with the WithVMClient option.
+type VMClient interface {
+	compiler_grpc.DvmCompilerClient
+	metadata_grpc.DVMBytecodeMetadataClient
+	vm_grpc.VMModulePublisherClient
+	vm_grpc.VMScriptExecutorClient
+}
+
+// vmGRpcClient is a VMClient gRPC implementation.
+type vmGRpcClient struct {
+	compiler_grpc.DvmCompilerClient
+	metadata_grpc.DVMBytecodeMetadataClient
+	vm_grpc.VMModulePublisherClient
+	vm_grpc.VMScriptExecutorClient
 }
 
 // NewVMClient creates VMClient using connection.
 func NewVMClient(connection *grpc.ClientConn) VMClient {
-	return VMClient{
-		VMCompilerClient:        compiler_grpc.NewDvmCompilerClient(connection),
-		VMMetaDataClient:        metadata_grpc.NewDVMBytecodeMetadataClient(connection),
-		VMModulePublisherClient: vm_grpc.NewVMModulePublisherClient(connection),
-		VMScriptExecutorClient:  vm_grpc.NewVMScriptExecutorClient(connection),
+	return vmGRpcClient{
+		DvmCompilerClient:         compiler_grpc.NewDvmCompilerClient(connection),
+		DVMBytecodeMetadataClient: metadata_grpc.NewDVMBytecodeMetadataClient(connection),
+		VMModulePublisherClient:   vm_grpc.NewVMModulePublisherClient(connection),
+		VMScriptExecutorClient:    vm_grpc.NewVMScriptExecutorClient(connection),
 	}
 }
 
@@ -40,26 +51,29 @@ func GetFreeGas(ctx sdk.Context) sdk.Gas {
 	return ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
 }
 
-// getVMLimitedGas returns gas less than VM max limit.
-func getVMLimitedGas(gas sdk.Gas) sdk.Gas {
-	if gas > VMMaxGasLimit {
-		return VMMaxGasLimit
-	}
-	return gas
-}
-
 // NewDeployContract creates an object used for publish module requests.
-func NewDeployContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte) *vm_grpc.VMPublishModule {
+// VM gas limit and gas price are defined by {params}.
+func NewDeployContract(address sdk.AccAddress, params types.Params, maxGas sdk.Gas, code []byte) *vm_grpc.VMPublishModule {
 	return &vm_grpc.VMPublishModule{
 		Sender:       common_vm.Bech32ToLibra(address),
-		MaxGasAmount: getVMLimitedGas(maxGas),
-		GasUnitPrice: types.VmGasPrice,
+		MaxGasAmount: params.GetVMMaxGas(maxGas),
+		GasUnitPrice: params.GasUnitPrice,
 		Code:         code,
 	}
 }
 
 // NewExecuteContract creates an object used for script execute requests.
-func NewExecuteContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte, args []types.ScriptArg) (*vm_grpc.VMExecuteScript, error) {
+// VM gas limit and gas price are defined by {params}.
+func NewExecuteContract(senders []sdk.AccAddress, params types.Params, maxGas sdk.Gas, code []byte, typeParams []types.MoveType, args []types.ScriptArg) (*vm_grpc.VMExecuteScript, error) {
+	var vmTypeParams []*vm_grpc.StructIdent
+	for _, typeParam := range typeParams {
+		structIdent, err := typeParam.StructIdent()
+		if err != nil {
+			return nil, err
+		}
+		vmTypeParams = append(vmTypeParams, structIdent)
+	}
+
 	vmArgs := make([]*vm_grpc.VMArgs, len(args))
 	for argIdx, arg := range args {
 		vmArgs[argIdx] = &vm_grpc.VMArgs{
@@ -68,24 +82,34 @@ func NewExecuteContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte, arg
 		}
 	}
 
+	vmSenders := make([][]byte, 0, len(senders))
+	for _, sender := range senders {
+		vmSenders = append(vmSenders, common_vm.Bech32ToLibra(sender))
+	}
+
 	return &vm_grpc.VMExecuteScript{
-		Senders:      [][]byte{common_vm.Bech32ToLibra(address)},
-		MaxGasAmount: getVMLimitedGas(maxGas),
-		GasUnitPrice: types.VmGasPrice,
+		Senders:      vmSenders,
+		MaxGasAmount: params.GetVMMaxGas(maxGas),
+		GasUnitPrice: params.GasUnitPrice,
 		Code:         code,
-		TypeParams:   nil,
+		TypeParams:   vmTypeParams,
 		Args:         vmArgs,
 	}, nil
 }
 
 // NewDeployRequest is a NewDeployContract wrapper: create deploy request.
-func NewDeployRequest(ctx sdk.Context, signer sdk.AccAddress, contract types.Contract) *vm_grpc.VMPublishModule {
-	return NewDeployContract(signer, GetFreeGas(ctx), contract)
+func NewDeployRequest(ctx sdk.Context, params types.Params, signer sdk.AccAddress, contract types.Contract) *vm_grpc.VMPublishModule {
+	return NewDeployContract(signer, params, GetFreeGas(ctx), contract)
 }
 
 // NewExecuteRequest is a NewExecuteContract wrapper: create execute request.
-func NewExecuteRequest(ctx sdk.Context, msg types.MsgExecuteScript) (*vm_grpc.VMExecuteScript, error) {
-	contract, err := NewExecuteContract(msg.Signer, GetFreeGas(ctx), msg.Script, msg.Args)
+func NewExecuteRequest(ctx sdk.Context, params types.Params, msg types.MsgExecuteScript) (*vm_grpc.VMExecuteScript, error) {
+	typeParams, err := msg.ParseTypeParams()
+	if err != nil {
+		return nil, sdkErrors.Wrap(types.ErrWrongTypeParam, err.Error())
+	}
+
+	contract, err := NewExecuteContract(msg.GetSigners(), params, GetFreeGas(ctx), msg.Script, typeParams, msg.Args)
 	if err != nil {
 		return nil, err
 	}
@@ -103,24 +103,47 @@ func TestVMKeeper_NewContract(t *testing.T) {
 	code := randomValue(1024)
 	argInputs := newArgInputs()
 	maxGas := uint64(1000000)
+	params := types.DefaultParams()
 
-	contractModule := NewDeployContract(addr, maxGas, code)
+	contractModule := NewDeployContract(addr, params, maxGas, code)
 	require.Equal(t, common_vm.Bech32ToLibra(addr), contractModule.Sender)
 	require.Equal(t, maxGas, contractModule.MaxGasAmount)
-	require.Equal(t, uint64(types.VmGasPrice), contractModule.GasUnitPrice)
+	require.Equal(t, params.GasUnitPrice, contractModule.GasUnitPrice)
 	require.Equal(t, code, contractModule.Code)
 
-	contractScript, err := NewExecuteContract(addr, maxGas, code, argInputs)
+	ethType, err := types.ParseMoveType("0x1::Coins::ETH", nil)
 	require.NoError(t, err)
-	require.Equal(t, [][]byte{common_vm.Bech32ToLibra(addr)}, contractScript.Senders)
+
+	coSignerAddr := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	contractScript, err := NewExecuteContract([]sdk.AccAddress{addr, coSignerAddr}, params, maxGas, code, []types.MoveType{ethType}, argInputs)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{common_vm.Bech32ToLibra(addr), common_vm.Bech32ToLibra(coSignerAddr)}, contractScript.Senders)
 	require.Equal(t, maxGas, contractScript.MaxGasAmount)
-	require.Equal(t, uint64(types.VmGasPrice), contractScript.GasUnitPrice)
+	require.Equal(t, params.GasUnitPrice, contractScript.GasUnitPrice)
 	require.Equal(t, code, contractScript.Code)
 	require.Equal(t, len(argInputs), len(contractScript.Args))
 	for i, contractArg := range contractScript.Args {
 		require.Equal(t, argInputs[i].Type, contractArg.Type)
 		require.Equal(t, argInputs[i].Value, contractArg.Value)
 	}
+	require.Len(t, contractScript.TypeParams, 1)
+	require.Equal(t, common_vm.StdLibAddress, contractScript.TypeParams[0].Address)
+	require.Equal(t, "Coins", contractScript.TypeParams[0].Module)
+	require.Equal(t, "ETH", contractScript.TypeParams[0].Name)
+
+	// custom gas schedule params
+	{
+		params := types.NewParams(10, 4, 100000, 0)
+
+		contractModule := NewDeployContract(addr, params, maxGas, code)
+		require.EqualValues(t, 100000, contractModule.MaxGasAmount)
+		require.EqualValues(t, 10, contractModule.GasUnitPrice)
+
+		contractScript, err := NewExecuteContract([]sdk.AccAddress{addr}, params, 1000, code, nil, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 250, contractScript.MaxGasAmount)
+		require.EqualValues(t, 10, contractScript.GasUnitPrice)
+	}
 }
 
 // Create new deploy request.
@@ -142,10 +165,11 @@ func TestVMKeeper_NewDeployRequest(t *testing.T) {
 	ctx := sdk.NewContext(mstore, abci.Header{ChainID: "dn-testnet-vm-keeper-test"}, false, log.NewNopLogger())
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
 
-	req := NewDeployRequest(ctx, addr, code)
+	params := types.DefaultParams()
+	req := NewDeployRequest(ctx, params, addr, code)
 
 	require.EqualValues(t, common_vm.Bech32ToLibra(addr), req.Sender)
 	require.EqualValues(t, gasLimit, req.MaxGasAmount)
-	require.EqualValues(t, types.VmGasPrice, req.GasUnitPrice)
+	require.EqualValues(t, params.GasUnitPrice, req.GasUnitPrice)
 	require.EqualValues(t, code, req.Code)
 }
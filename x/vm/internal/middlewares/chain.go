@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	// Reserved stdlib struct name for the chain info resource
+	ChainInfoStruct = "ChainInfo"
+)
+
+type ChainInfo struct {
+	ChainID  []byte
+	Proposer []byte `lcs:"len=20"`
+}
+
+// ChainInfoPath returns 0x1::Block::ChainInfo resource VM path.
+func ChainInfoPath() []byte {
+	var stdLibAddress [common_vm.VMAddressLength]byte
+	copy(stdLibAddress[:], common_vm.StdLibAddress)
+
+	return glav.NewStructTag(stdLibAddress, glav.BlockModule, ChainInfoStruct, nil).AccessVector()
+}
+
+// NewChainInfoMiddleware creates DS server middleware which return current chainID and block proposer address.
+func NewChainInfoMiddleware() common_vm.DSDataMiddleware {
+	chainInfoPath := vm_grpc.VMAccessPath{
+		Address: common_vm.StdLibAddress,
+		Path:    ChainInfoPath(),
+	}
+
+	return func(ctx sdk.Context, path *vm_grpc.VMAccessPath) (data []byte, err error) {
+		if bytes.Equal(chainInfoPath.Address, path.Address) && bytes.Equal(chainInfoPath.Path, path.Path) {
+			proposer := make([]byte, common_vm.VMAddressLength)
+			copy(proposer, ctx.BlockHeader().ProposerAddress)
+
+			return lcs.Marshal(ChainInfo{
+				ChainID:  []byte(ctx.ChainID()),
+				Proposer: proposer,
+			})
+		}
+
+		return
+	}
+}
@@ -15,6 +15,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*PlannedProposal)(nil), nil)
 	cdc.RegisterConcrete(TestProposal{}, ModuleName+"/TestProposal", nil)
 	cdc.RegisterConcrete(StdlibUpdateProposal{}, ModuleName+"/StdlibUpdateProposal", nil)
+	cdc.RegisterConcrete(ModulePublishProposal{}, ModuleName+"/ModulePublishProposal", nil)
 }
 
 func init() {
@@ -24,4 +25,8 @@ func init() {
 
 	gov.RegisterProposalType(ProposalTypeStdlibUpdate)
 	gov.RegisterProposalTypeCodec(StdlibUpdateProposal{}, GovRouterKey+"/StdlibUpdateProposal")
+	gov.RegisterProposalType(ProposalTypeModulePublish)
+	gov.RegisterProposalTypeCodec(ModulePublishProposal{}, GovRouterKey+"/ModulePublishProposal")
+	gov.RegisterProposalType(ProposalTypeCancelScheduled)
+	gov.RegisterProposalTypeCodec(CancelScheduledProposal{}, GovRouterKey+"/CancelScheduledProposal")
 }
@@ -3,10 +3,12 @@ package types
 const (
 	ModuleName   = "vm"
 	StoreKey     = ModuleName
+	TStoreKey    = "transient_" + ModuleName
 	RouterKey    = ModuleName
 	GovRouterKey = ModuleName
 	//
-	VmGasPrice       = 1 // gas unit price for VM execution
+	DefaultParamspace = ModuleName
+	//
 	VmUnknownTagType = -1
 	// VM Event to sdk.Event conversion params
 	EventTypeProcessingGas = 10000 // initial gas for processing event type.
@@ -9,10 +9,13 @@ var (
 	ErrEmptyContract = sdkErrors.Register(ModuleName, 101, "contract code is empty")
 	ErrVMCrashed     = sdkErrors.Register(ModuleName, 102, "VM has crashed / not reachable") // error breaks consensus
 	ErrNotFound      = sdkErrors.Register(ModuleName, 103, "not found")
+	ErrIndexDisabled = sdkErrors.Register(ModuleName, 104, "indexer is disabled")
+	ErrVMUnavailable = sdkErrors.Register(ModuleName, 105, "VM is temporary unavailable, retry later") // error is retryable
 
 	ErrWrongArgTypeTag        = sdkErrors.Register(ModuleName, 200, "invalid argument type")
 	ErrWrongArgValue          = sdkErrors.Register(ModuleName, 201, "invalid argument value")
 	ErrWrongExecutionResponse = sdkErrors.Register(ModuleName, 202, "wrong execution response from VM")
+	ErrWrongTypeParam         = sdkErrors.Register(ModuleName, 203, "invalid type param")
 
 	ErrGovInvalidProposal = sdkErrors.Register(ModuleName, 500, "invalid proposal")
 )
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// Transient storage prefix for the current block VM events (tx hash -> items)
+	TxEventsPrefix = []byte("tx_events")
+	// Events indexer DB prefixes: item (height | tx hash | event index -> item)
+	EventIndexItemPrefix = []byte{0x01}
+	// Events indexer DB prefixes: by type / by sender secondary indices ({type / sender} | 0x00 | height | tx hash | event index -> item key)
+	EventIndexTypePrefix   = []byte{0x02}
+	EventIndexSenderPrefix = []byte{0x03}
+	//
+	eventIndexSeparator = []byte{0x00}
+)
+
+// GetTxEventsKey returns transient storage key for transaction VM events.
+func GetTxEventsKey(txHash []byte) []byte {
+	return append(append([]byte{}, TxEventsPrefix...), txHash...)
+}
+
+// GetEventIndexItemKey returns events indexer DB item key.
+func GetEventIndexItemKey(height int64, txHash []byte, eventIdx uint32) []byte {
+	return append(append([]byte{}, EventIndexItemPrefix...), getEventIndexKeySuffix(height, txHash, eventIdx)...)
+}
+
+// GetEventIndexTypeKey returns events indexer DB by type index key.
+func GetEventIndexTypeKey(eventType string, height int64, txHash []byte, eventIdx uint32) []byte {
+	return append(GetEventIndexTypePrefix(eventType), getEventIndexKeySuffix(height, txHash, eventIdx)...)
+}
+
+// GetEventIndexSenderKey returns events indexer DB by sender index key.
+func GetEventIndexSenderKey(sender string, height int64, txHash []byte, eventIdx uint32) []byte {
+	return append(GetEventIndexSenderPrefix(sender), getEventIndexKeySuffix(height, txHash, eventIdx)...)
+}
+
+// GetEventIndexTypePrefix returns events indexer DB by type index prefix for the {eventType}.
+func GetEventIndexTypePrefix(eventType string) []byte {
+	return getEventIndexSecondaryPrefix(EventIndexTypePrefix, eventType)
+}
+
+// GetEventIndexSenderPrefix returns events indexer DB by sender index prefix for the {sender}.
+func GetEventIndexSenderPrefix(sender string) []byte {
+	return getEventIndexSecondaryPrefix(EventIndexSenderPrefix, sender)
+}
+
+// GetEventIndexHeightKey returns {prefix} key for the block height (used to build height range iterators).
+func GetEventIndexHeightKey(prefix []byte, height int64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+
+	return append(append([]byte{}, prefix...), heightBz...)
+}
+
+func getEventIndexSecondaryPrefix(prefix []byte, value string) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, []byte(value)...)
+
+	return append(key, eventIndexSeparator...)
+}
+
+func getEventIndexKeySuffix(height int64, txHash []byte, eventIdx uint32) []byte {
+	key := GetEventIndexHeightKey(nil, height)
+	key = append(key, txHash...)
+
+	idxBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(idxBz, eventIdx)
+
+	return append(key, idxBz...)
+}
+
+// EventIndexItem is an indexed VM event.
+type EventIndexItem struct {
+	// Transaction hash (HEX string)
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
+	// Block height
+	Height int64 `json:"height" yaml:"height"`
+	// Event index within the transaction
+	Index uint32 `json:"index" yaml:"index"`
+	// Event sender address (0x1 for stdlib, Bech32 otherwise)
+	Sender string `json:"sender" yaml:"sender"`
+	// Event source (script / module)
+	Source string `json:"source" yaml:"source"`
+	// Event Move type
+	Type string `json:"type" yaml:"type"`
+	// Event data (HEX string)
+	Data string `json:"data" yaml:"data"`
+	// Decoded event data (JSON string), empty if decoding is disabled / failed
+	DataJSON string `json:"data_json,omitempty" yaml:"data_json,omitempty"`
+}
+
+func (item EventIndexItem) String() string {
+	return fmt.Sprintf("EventIndexItem:\n"+
+		"  TxHash: %s\n"+
+		"  Height: %d\n"+
+		"  Index: %d\n"+
+		"  Sender: %s\n"+
+		"  Source: %s\n"+
+		"  Type: %s\n"+
+		"  Data: %s\n"+
+		"  DataJSON: %s",
+		item.TxHash, item.Height, item.Index, item.Sender, item.Source, item.Type, item.Data, item.DataJSON,
+	)
+}
+
+// NewEventIndexItem creates a new EventIndexItem object using Move event attributes (tx hash and height are set on flush).
+func NewEventIndexItem(event sdk.Event, eventIdx uint32) EventIndexItem {
+	item := EventIndexItem{
+		Index: eventIdx,
+	}
+
+	for _, attr := range event.Attributes {
+		value := string(attr.Value)
+		switch string(attr.Key) {
+		case AttributeVmEventSender:
+			item.Sender = value
+		case AttributeVmEventSource:
+			item.Source = value
+		case AttributeVmEventType:
+			item.Type = value
+		case AttributeVmEventData:
+			item.Data = value
+		case AttributeVmEventDataJSON:
+			item.DataJSON = value
+		}
+	}
+
+	return item
+}
+
+// EventIndexItems is a slice of EventIndexItem objects.
+type EventIndexItems []EventIndexItem
+
+func (items EventIndexItems) String() string {
+	strBuilder := strings.Builder{}
+	for i, item := range items {
+		strBuilder.WriteString(item.String())
+		if i < len(items)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// Client request for indexed VM events.
+type EventsReq struct {
+	// Event Move type filter (exact match, optional)
+	EventType string `json:"event_type" yaml:"event_type"`
+	// Event sender filter (0x1 for stdlib, Bech32 otherwise; optional)
+	Sender string `json:"sender" yaml:"sender"`
+	// Block height range start (inclusive, optional)
+	FromHeight int64 `json:"from_height" yaml:"from_height"`
+	// Block height range end (inclusive, 0 - no limit)
+	ToHeight int64 `json:"to_height" yaml:"to_height"`
+	// Page number (first page: 1)
+	Page sdk.Uint `json:"page" yaml:"page"`
+	// Items per page
+	Limit sdk.Uint `json:"limit" yaml:"limit"`
+}
+
+// Validate checks request heights range and pagination.
+func (r EventsReq) Validate() error {
+	if r.FromHeight < 0 {
+		return fmt.Errorf("from_height: negative")
+	}
+	if r.ToHeight < 0 {
+		return fmt.Errorf("to_height: negative")
+	}
+	if r.ToHeight != 0 && r.ToHeight < r.FromHeight {
+		return fmt.Errorf("to_height: less than from_height")
+	}
+	if err := validatePagination(r.Page, r.Limit); err != nil {
+		return err
+	}
+
+	return nil
+}
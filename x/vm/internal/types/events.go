@@ -23,6 +23,7 @@ const (
 	AttributeVmEventSource      = "source"
 	AttributeVmEventType        = "type"
 	AttributeVmEventData        = "data"
+	AttributeVmEventDataJSON    = "data_json"
 	//
 	AttributeValueStatusKeep      = "keep"
 	AttributeValueStatusDiscard   = "discard"
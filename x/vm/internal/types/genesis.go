@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -11,7 +12,12 @@ import (
 
 // GenesisState is module's genesis (initial state).
 type GenesisState struct {
+	// Module params (default params are used if not set)
+	Parameters *Params `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	//
 	WriteSet []GenesisWriteOp `json:"write_set" yaml:"write_set"`
+	// Published modules registry
+	Modules ModuleInfos `json:"modules,omitempty" yaml:"modules,omitempty"`
 }
 
 // Genesis writeSet operation.
@@ -52,6 +58,12 @@ func (writeOp GenesisWriteOp) ToBytes() (*vm_grpc.VMAccessPath, []byte, error) {
 
 // Validate checks that genesis state is valid.
 func (s GenesisState) Validate() error {
+	if s.Parameters != nil {
+		if err := s.Parameters.Validate(); err != nil {
+			return fmt.Errorf("parameters: %w", err)
+		}
+	}
+
 	writeOpsSet := make(map[string]bool, len(s.WriteSet))
 	for woIdx, writeOp := range s.WriteSet {
 		bzAddr, err := hex.DecodeString(writeOp.Address)
@@ -77,9 +89,63 @@ func (s GenesisState) Validate() error {
 		writeOpsSet[writeOpId] = true
 	}
 
+	modulesSet := make(map[string]bool, len(s.Modules))
+	for mIdx, module := range s.Modules {
+		if err := module.Validate(); err != nil {
+			return fmt.Errorf("modules[%d]: %w", mIdx, err)
+		}
+
+		moduleId := module.Address + "::" + module.Name
+		if modulesSet[moduleId] {
+			return fmt.Errorf("modules[%d]: duplicated %q", mIdx, moduleId)
+		}
+		modulesSet[moduleId] = true
+	}
+
 	return nil
 }
 
+// WriteSetFilter filters VM writeSets by addresses and path prefixes (empty filter field matches any value).
+type WriteSetFilter struct {
+	Addresses    [][]byte
+	PathPrefixes [][]byte
+}
+
+// Match checks if VM access path matches the filter.
+func (f WriteSetFilter) Match(accessPath *vm_grpc.VMAccessPath) bool {
+	return f.MatchAddress(accessPath.Address) && f.matchPath(accessPath.Path)
+}
+
+// MatchAddress checks if VM address matches the filter.
+func (f WriteSetFilter) MatchAddress(address []byte) bool {
+	if len(f.Addresses) == 0 {
+		return true
+	}
+
+	for _, filterAddress := range f.Addresses {
+		if bytes.Equal(filterAddress, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPath checks if VM path matches the filter.
+func (f WriteSetFilter) matchPath(path []byte) bool {
+	if len(f.PathPrefixes) == 0 {
+		return true
+	}
+
+	for _, filterPrefix := range f.PathPrefixes {
+		if bytes.HasPrefix(path, filterPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // DefaultGenesisState returns default genesis state (validation is done on module init).
 func DefaultGenesisState() GenesisState {
 	return GenesisState{}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	ProposalTypeCancelScheduled = "CancelScheduled"
+)
+
+var (
+	_ gov.Content = CancelScheduledProposal{}
+)
+
+// CancelScheduledProposal is a gov proposal used to remove a scheduled proposal from the VM gov proposal queue.
+type CancelScheduledProposal struct {
+	// Scheduled proposal ID
+	ProposalID uint64 `json:"proposal_id"`
+	// Cancel reason
+	Reason string `json:"reason"`
+}
+
+func (p CancelScheduledProposal) GetTitle() string       { return "DVM scheduled proposal cancel" }
+func (p CancelScheduledProposal) GetDescription() string { return "Cancels scheduled VM proposal" }
+func (p CancelScheduledProposal) ProposalRoute() string  { return GovRouterKey }
+func (p CancelScheduledProposal) ProposalType() string   { return ProposalTypeCancelScheduled }
+
+func (p CancelScheduledProposal) ValidateBasic() error {
+	if p.Reason == "" {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "reason: empty")
+	}
+
+	return nil
+}
+
+func (p CancelScheduledProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Scheduled proposal ID: %d\n", p.ProposalID))
+	b.WriteString(fmt.Sprintf("  Reason: %s\n", p.Reason))
+
+	return b.String()
+}
+
+// NewCancelScheduledProposal creates a CancelScheduledProposal object.
+func NewCancelScheduledProposal(proposalID uint64, reason string) gov.Content {
+	return CancelScheduledProposal{
+		ProposalID: proposalID,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	ProposalTypeModulePublish = "ModulePublish"
+)
+
+var (
+	_ gov.Content     = ModulePublishProposal{}
+	_ PlannedProposal = ModulePublishProposal{}
+)
+
+// ModulePublishProposal is a gov proposal used to publish modules to the address.
+type ModulePublishProposal struct {
+	// Modules address
+	Address sdk.AccAddress `json:"address"`
+	// Modules source URL
+	Url string `json:"url"`
+	// Publish description
+	PublishDescription string `json:"publish_description"`
+	// Proposal plan
+	Plan Plan `json:"plan"`
+	// Modules bytecode
+	Code []Contract `json:"code"`
+}
+
+func (p ModulePublishProposal) GetTitle() string       { return "DVM modules publish" }
+func (p ModulePublishProposal) GetDescription() string { return "Publishes DVM modules to the address" }
+func (p ModulePublishProposal) ProposalRoute() string  { return GovRouterKey }
+func (p ModulePublishProposal) ProposalType() string   { return ProposalTypeModulePublish }
+func (p ModulePublishProposal) GetPlan() Plan          { return p.Plan }
+
+func (p ModulePublishProposal) ValidateBasic() error {
+	if err := p.Plan.ValidateBasic(); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "plan: %v", err)
+	}
+
+	if p.Address.Empty() {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "address: empty")
+	}
+	if p.Url == "" {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "url: empty")
+	}
+	if _, err := url.Parse(p.Url); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "url: %v", err)
+	}
+	if p.PublishDescription == "" {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "publishDescription: empty")
+	}
+	if len(p.Code) == 0 {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "code: empty")
+	}
+	for i, code := range p.Code {
+		if len(code) == 0 {
+			return sdkErrors.Wrapf(ErrGovInvalidProposal, "code[%d]: empty", i)
+		}
+	}
+
+	return nil
+}
+
+func (p ModulePublishProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  %s", p.Plan.String()))
+	b.WriteString(fmt.Sprintf("  Address: %s\n", p.Address))
+	b.WriteString(fmt.Sprintf("  Modules: %d\n", len(p.Code)))
+	b.WriteString(fmt.Sprintf("  Source URL: %s\n", p.Url))
+	b.WriteString(fmt.Sprintf("  Publish description: %s\n", p.PublishDescription))
+
+	return b.String()
+}
+
+// NewModulePublishProposal creates a ModulePublishProposal object.
+func NewModulePublishProposal(plan Plan, address sdk.AccAddress, url, publishDescription string, code []Contract) gov.Content {
+	return ModulePublishProposal{
+		Plan:               plan,
+		Address:            address,
+		Url:                url,
+		PublishDescription: publishDescription,
+		Code:               code,
+	}
+}
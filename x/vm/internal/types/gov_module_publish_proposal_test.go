@@ -0,0 +1,37 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVM_ModulePublishProposal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	code := []Contract{{1}}
+
+	// ok
+	require.NoError(t, NewModulePublishProposal(NewPlan(1), addr, "http://github.com/repo", "tst", code).ValidateBasic())
+
+	// check plan validation
+	require.Error(t, NewModulePublishProposal(NewPlan(0), addr, "http://github.com/repo", "tst", code).ValidateBasic())
+
+	// check parameters validation
+	require.Error(t, NewModulePublishProposal(NewPlan(1), nil, "http://github.com/repo", "tst", code).ValidateBasic())
+	require.Error(t, NewModulePublishProposal(NewPlan(1), addr, "", "tst", code).ValidateBasic())
+	require.Error(t, NewModulePublishProposal(NewPlan(1), addr, "1://repo", "tst", code).ValidateBasic())
+	require.Error(t, NewModulePublishProposal(NewPlan(1), addr, "http://github.com/repo", "", code).ValidateBasic())
+	require.Error(t, NewModulePublishProposal(NewPlan(1), addr, "http://github.com/repo", "tst", nil).ValidateBasic())
+	require.Error(t, NewModulePublishProposal(NewPlan(1), addr, "http://github.com/repo", "tst", []Contract{{}}).ValidateBasic())
+}
+
+func TestVM_CancelScheduledProposal(t *testing.T) {
+	// ok
+	require.NoError(t, NewCancelScheduledProposal(0, "bug").ValidateBasic())
+
+	// check parameters validation
+	require.Error(t, NewCancelScheduledProposal(1, "").ValidateBasic())
+}
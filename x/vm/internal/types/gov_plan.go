@@ -11,6 +11,7 @@ import (
 // PlannedProposal is interface for all VM module proposals.
 type PlannedProposal interface {
 	fmt.Stringer
+	ProposalType() string
 	GetPlan() Plan
 }
 
@@ -47,3 +48,40 @@ func (p Plan) ShouldExecute(ctx sdk.Context) bool {
 func NewPlan(blockHeight int64) Plan {
 	return Plan{Height: blockHeight}
 }
+
+// ScheduledProposal is a gov proposal queue item.
+type ScheduledProposal struct {
+	// Queue ID (used to cancel the proposal)
+	ID uint64 `json:"id" yaml:"id"`
+	// Proposal type
+	Type string `json:"type" yaml:"type"`
+	// Proposal plan
+	Plan Plan `json:"plan" yaml:"plan"`
+	// Proposal content
+	Proposal PlannedProposal `json:"proposal" yaml:"proposal"`
+}
+
+func (p ScheduledProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("ScheduledProposal:\n")
+	b.WriteString(fmt.Sprintf("  ID: %d\n", p.ID))
+	b.WriteString(fmt.Sprintf("  Type: %s\n", p.Type))
+	b.WriteString(fmt.Sprintf("  BlockHeight: %d\n", p.Plan.Height))
+	if p.Proposal != nil {
+		b.WriteString(p.Proposal.String())
+	}
+
+	return b.String()
+}
+
+// ScheduledProposals is a slice of ScheduledProposal objects.
+type ScheduledProposals []ScheduledProposal
+
+func (list ScheduledProposals) String() string {
+	b := strings.Builder{}
+	for _, p := range list {
+		b.WriteString(p.String())
+	}
+
+	return b.String()
+}
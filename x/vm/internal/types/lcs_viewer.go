@@ -1,15 +1,38 @@
 package types
 
+import (
+	"fmt"
+)
+
 type ViewerType string
 
 const (
 	ViewerTypeU8      ViewerType = "U8"
+	ViewerTypeU16     ViewerType = "U16"
+	ViewerTypeU32     ViewerType = "U32"
 	ViewerTypeU64     ViewerType = "U64"
 	ViewerTypeU128    ViewerType = "U128"
+	ViewerTypeU256    ViewerType = "U256"
 	ViewerTypeBool    ViewerType = "bool"
 	ViewerTypeAddress ViewerType = "address"
+	ViewerTypeSigner  ViewerType = "signer"
 	ViewerTypeStruct  ViewerType = "struct"
 	ViewerTypeVector  ViewerType = "vector"
+	ViewerTypeOption  ViewerType = "option"
+)
+
+// ViewerFormat defines output format for address and vector<u8> fields.
+type ViewerFormat string
+
+const (
+	// Default format: bytes array for address, base64 string for vector<u8>
+	ViewerFormatDefault ViewerFormat = ""
+	// HEX string (address, vector<u8>)
+	ViewerFormatHex ViewerFormat = "hex"
+	// UTF-8 string (vector<u8>)
+	ViewerFormatString ViewerFormat = "string"
+	// Bech32 string (address)
+	ViewerFormatBech32 ViewerFormat = "bech32"
 )
 
 type ViewerRequest []ViewerItem
@@ -17,5 +40,67 @@ type ViewerRequest []ViewerItem
 type ViewerItem struct {
 	Name      string         `json:"name"`
 	Type      ViewerType     `json:"type"`
+	Format    ViewerFormat   `json:"format,omitempty"`
 	InnerItem *ViewerRequest `json:"inner_item"`
 }
+
+// IsAddress checks if item is an address / signer.
+func (item ViewerItem) IsAddress() bool {
+	return item.Type == ViewerTypeAddress || item.Type == ViewerTypeSigner
+}
+
+// IsBytes checks if item is a vector<u8>.
+func (item ViewerItem) IsBytes() bool {
+	return item.Type == ViewerTypeVector && item.InnerItem != nil && len(*item.InnerItem) == 1 && (*item.InnerItem)[0].Type == ViewerTypeU8
+}
+
+// ValidateFormat checks that item format is supported for its type.
+func (item ViewerItem) ValidateFormat() error {
+	switch item.Format {
+	case ViewerFormatDefault:
+		return nil
+	case ViewerFormatHex:
+		if item.IsAddress() || item.IsBytes() {
+			return nil
+		}
+	case ViewerFormatBech32:
+		if item.IsAddress() {
+			return nil
+		}
+	case ViewerFormatString:
+		if item.IsBytes() {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown format %q", item.Format)
+	}
+
+	return fmt.Errorf("format %q is not supported for the type", item.Format)
+}
+
+// WithDefaultFormats sets {bytesFormat} / {addressFormat} for vector<u8> / address items without format (recursively).
+func (r ViewerRequest) WithDefaultFormats(bytesFormat, addressFormat ViewerFormat) ViewerRequest {
+	if r == nil {
+		return nil
+	}
+
+	out := make(ViewerRequest, 0, len(r))
+	for _, item := range r {
+		if item.Format == ViewerFormatDefault {
+			if item.IsBytes() {
+				item.Format = bytesFormat
+			} else if item.IsAddress() {
+				item.Format = addressFormat
+			}
+		}
+
+		if item.InnerItem != nil {
+			inner := item.InnerItem.WithDefaultFormats(bytesFormat, addressFormat)
+			item.InnerItem = &inner
+		}
+
+		out = append(out, item)
+	}
+
+	return out
+}
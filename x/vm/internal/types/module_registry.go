@@ -0,0 +1,242 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/metadata_grpc"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	// Max length of the MsgDeployModule source field
+	ModuleSourceMaxLength = 256
+)
+
+var (
+	ModuleInfoPrefix = []byte("module_info")
+)
+
+// GetModuleInfoAddressPrefix returns storage key prefix for the VM address published modules (used for iteration).
+func GetModuleInfoAddressPrefix(address []byte) []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleInfoPrefix,
+			address,
+			{},
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetModuleInfoKey returns published module registry entry storage key.
+func GetModuleInfoKey(address []byte, name string) []byte {
+	return append(GetModuleInfoAddressPrefix(address), []byte(name)...)
+}
+
+// ModuleInfo is a published module registry entry.
+type ModuleInfo struct {
+	// Module VM address (HEX string)
+	Address string `json:"address" yaml:"address"`
+	// Module name
+	Name string `json:"name" yaml:"name"`
+	// Module publisher
+	Publisher sdk.AccAddress `json:"publisher" yaml:"publisher"`
+	// Block height module was published at
+	Height int64 `json:"height" yaml:"height"`
+	// Module bytecode SHA256 hash (HEX string)
+	CodeHash string `json:"code_hash" yaml:"code_hash"`
+	// Module source URL / hash (optional, set by publisher)
+	Source string `json:"source" yaml:"source"`
+	// Module ABI
+	ABI ModuleABI `json:"abi" yaml:"abi"`
+}
+
+// Validate checks registry entry.
+func (m ModuleInfo) Validate() error {
+	bzAddr, err := hex.DecodeString(m.Address)
+	if err != nil {
+		return fmt.Errorf("address %q: %w", m.Address, err)
+	}
+	if len(bzAddr) != common_vm.VMAddressLength {
+		return fmt.Errorf("address %q: incorrect length, should be %d bytes length", m.Address, common_vm.VMAddressLength)
+	}
+
+	if m.Name == "" {
+		return fmt.Errorf("name: empty")
+	}
+
+	if m.Publisher.Empty() {
+		return fmt.Errorf("publisher: empty")
+	}
+
+	if m.Height < 0 {
+		return fmt.Errorf("height: negative")
+	}
+
+	if _, err := hex.DecodeString(m.CodeHash); err != nil {
+		return fmt.Errorf("code_hash %q: %w", m.CodeHash, err)
+	}
+
+	if len(m.Source) > ModuleSourceMaxLength {
+		return fmt.Errorf("source: max length exceeded (%d)", ModuleSourceMaxLength)
+	}
+
+	return nil
+}
+
+func (m ModuleInfo) String() string {
+	return fmt.Sprintf("ModuleInfo:\n"+
+		"  Address: %s\n"+
+		"  Name: %s\n"+
+		"  Publisher: %s\n"+
+		"  Height: %d\n"+
+		"  CodeHash: %s\n"+
+		"  Source: %s\n"+
+		"%s",
+		m.Address, m.Name, m.Publisher, m.Height, m.CodeHash, m.Source, m.ABI.String(),
+	)
+}
+
+// ModuleInfos is a slice of ModuleInfo objects.
+type ModuleInfos []ModuleInfo
+
+func (list ModuleInfos) String() string {
+	strBuilder := strings.Builder{}
+	for i, info := range list {
+		strBuilder.WriteString(info.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// ModuleABI is a module types and functions signatures.
+type ModuleABI struct {
+	Structs   []StructABI   `json:"structs" yaml:"structs"`
+	Functions []FunctionABI `json:"functions" yaml:"functions"`
+}
+
+// StructABI is a module struct signature.
+type StructABI struct {
+	Name           string     `json:"name" yaml:"name"`
+	IsResource     bool       `json:"is_resource" yaml:"is_resource"`
+	TypeParameters []string   `json:"type_parameters" yaml:"type_parameters"`
+	Fields         []FieldABI `json:"fields" yaml:"fields"`
+}
+
+// FieldABI is a module struct field signature.
+type FieldABI struct {
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// FunctionABI is a module function signature.
+type FunctionABI struct {
+	Name           string   `json:"name" yaml:"name"`
+	IsPublic       bool     `json:"is_public" yaml:"is_public"`
+	IsNative       bool     `json:"is_native" yaml:"is_native"`
+	TypeParameters []string `json:"type_parameters" yaml:"type_parameters"`
+	Arguments      []string `json:"arguments" yaml:"arguments"`
+	Returns        []string `json:"returns" yaml:"returns"`
+}
+
+// String returns Move formatted function signature.
+func (f FunctionABI) String() string {
+	str := ""
+	if f.IsPublic {
+		str += "public "
+	}
+	if f.IsNative {
+		str += "native "
+	}
+	str += "fun " + f.Name
+	if len(f.TypeParameters) > 0 {
+		str += "<" + strings.Join(f.TypeParameters, ", ") + ">"
+	}
+	str += "(" + strings.Join(f.Arguments, ", ") + ")"
+	if len(f.Returns) > 0 {
+		str += ": " + strings.Join(f.Returns, ", ")
+	}
+
+	return str
+}
+
+func (abi ModuleABI) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("  Structs:\n")
+	for _, s := range abi.Structs {
+		fields := make([]string, 0, len(s.Fields))
+		for _, f := range s.Fields {
+			fields = append(fields, f.Name+": "+f.Type)
+		}
+
+		kind := "struct"
+		if s.IsResource {
+			kind = "resource struct"
+		}
+		typeParams := ""
+		if len(s.TypeParameters) > 0 {
+			typeParams = "<" + strings.Join(s.TypeParameters, ", ") + ">"
+		}
+
+		strBuilder.WriteString(fmt.Sprintf("    %s %s%s { %s }\n", kind, s.Name, typeParams, strings.Join(fields, ", ")))
+	}
+	strBuilder.WriteString("  Functions:\n")
+	for _, f := range abi.Functions {
+		strBuilder.WriteString("    " + f.String() + "\n")
+	}
+
+	return strBuilder.String()
+}
+
+// NewModuleABI converts DVM module metadata to ModuleABI.
+func NewModuleABI(meta *metadata_grpc.ModuleMeta) ModuleABI {
+	abi := ModuleABI{
+		Structs:   make([]StructABI, 0, len(meta.GetTypes())),
+		Functions: make([]FunctionABI, 0, len(meta.GetFunctions())),
+	}
+
+	for _, s := range meta.GetTypes() {
+		structABI := StructABI{
+			Name:           s.GetName(),
+			IsResource:     s.GetIsResource(),
+			TypeParameters: s.GetTypeParameters(),
+			Fields:         make([]FieldABI, 0, len(s.GetField())),
+		}
+		for _, f := range s.GetField() {
+			structABI.Fields = append(structABI.Fields, FieldABI{Name: f.GetName(), Type: f.GetType()})
+		}
+		abi.Structs = append(abi.Structs, structABI)
+	}
+
+	for _, f := range meta.GetFunctions() {
+		abi.Functions = append(abi.Functions, FunctionABI{
+			Name:           f.GetName(),
+			IsPublic:       f.GetIsPublic(),
+			IsNative:       f.GetIsNative(),
+			TypeParameters: f.GetTypeParameters(),
+			Arguments:      f.GetArguments(),
+			Returns:        f.GetReturns(),
+		})
+	}
+
+	return abi
+}
+
+// Client request for the address published modules.
+type ModulesReq struct {
+	Address []byte `json:"address" yaml:"address"`
+}
+
+// Client request for the published module.
+type ModuleReq struct {
+	Address []byte `json:"address" yaml:"address"`
+	Name    string `json:"name" yaml:"name"`
+}
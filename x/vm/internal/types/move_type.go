@@ -0,0 +1,405 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// MoveType is a parsed Move type (e.g. "u64", "vector<u8>", "0x1::Coins::ETH", "T").
+type MoveType struct {
+	// Primitive / vector / struct type (empty for a type parameter)
+	Kind ViewerType
+	// Struct module address
+	Address []byte
+	// Struct module name
+	Module string
+	// Struct name or type parameter name
+	Name string
+	// Vector element type / struct type arguments
+	TypeArgs []MoveType
+}
+
+// IsParam checks if type is a generic type parameter.
+func (t MoveType) IsParam() bool {
+	return t.Kind == ""
+}
+
+// IsOption checks if type is a Move Option<T> struct.
+func (t MoveType) IsOption() bool {
+	return t.Kind == ViewerTypeStruct && t.Module == "Option" && t.Name == "Option"
+}
+
+func (t MoveType) String() string {
+	switch {
+	case t.IsParam():
+		return t.Name
+	case t.Kind == ViewerTypeVector:
+		return fmt.Sprintf("vector<%s>", t.TypeArgs[0].String())
+	case t.Kind == ViewerTypeStruct:
+		str := fmt.Sprintf("0x%s::%s::%s", hex.EncodeToString(t.Address), t.Module, t.Name)
+		if len(t.TypeArgs) > 0 {
+			args := make([]string, 0, len(t.TypeArgs))
+			for _, arg := range t.TypeArgs {
+				args = append(args, arg.String())
+			}
+			str += "<" + strings.Join(args, ", ") + ">"
+		}
+		return str
+	}
+
+	return strings.ToLower(string(t.Kind))
+}
+
+// StructTag converts struct type to glav.StructTag (used to build VM path).
+func (t MoveType) StructTag() (glav.StructTag, error) {
+	if t.Kind != ViewerTypeStruct {
+		return glav.StructTag{}, fmt.Errorf("%s: not a struct", t.String())
+	}
+
+	var address [20]byte
+	copy(address[:], t.Address)
+
+	typeParams := make([]glav.TypeParam, 0, len(t.TypeArgs))
+	for _, arg := range t.TypeArgs {
+		typeParam, err := arg.TypeParam()
+		if err != nil {
+			return glav.StructTag{}, err
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+
+	return glav.NewStructTag(address, t.Module, t.Name, typeParams), nil
+}
+
+// TypeParam converts type to glav.TypeParam (used to build VM path).
+func (t MoveType) TypeParam() (glav.TypeParam, error) {
+	switch t.Kind {
+	case ViewerTypeU8:
+		return glav.NewU8TypeParam(), nil
+	case ViewerTypeU64:
+		return glav.NewU64TypeParam(), nil
+	case ViewerTypeU128:
+		return glav.NewU128TypeParam(), nil
+	case ViewerTypeBool:
+		return glav.NewBoolTypeParam(), nil
+	case ViewerTypeAddress:
+		return glav.NewAddressTypeParam(), nil
+	case ViewerTypeSigner:
+		return glav.NewSignerTypeParam(), nil
+	case ViewerTypeVector:
+		elemParam, err := t.TypeArgs[0].TypeParam()
+		if err != nil {
+			return glav.TypeParam{}, err
+		}
+		return glav.NewVectorTypeParam(elemParam), nil
+	case ViewerTypeStruct:
+		tag, err := t.StructTag()
+		if err != nil {
+			return glav.TypeParam{}, err
+		}
+		return glav.NewStructTypeParam(tag), nil
+	}
+
+	return glav.TypeParam{}, fmt.Errorf("%s: type param is not supported", t.String())
+}
+
+// StructIdent converts struct type to DVM script type param.
+func (t MoveType) StructIdent() (*vm_grpc.StructIdent, error) {
+	if t.Kind != ViewerTypeStruct {
+		return nil, fmt.Errorf("%s: not a struct", t.String())
+	}
+
+	typeParams := make([]*vm_grpc.LcsTag, 0, len(t.TypeArgs))
+	for _, arg := range t.TypeArgs {
+		typeParam, err := arg.LcsTag()
+		if err != nil {
+			return nil, err
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+
+	return &vm_grpc.StructIdent{
+		Address:    t.Address,
+		Module:     t.Module,
+		Name:       t.Name,
+		TypeParams: typeParams,
+	}, nil
+}
+
+// LcsTag converts type to DVM LCS type tag.
+func (t MoveType) LcsTag() (*vm_grpc.LcsTag, error) {
+	switch t.Kind {
+	case ViewerTypeU8:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU8}, nil
+	case ViewerTypeU64:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU64}, nil
+	case ViewerTypeU128:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsU128}, nil
+	case ViewerTypeBool:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsBool}, nil
+	case ViewerTypeAddress:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsAddress}, nil
+	case ViewerTypeSigner:
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsSigner}, nil
+	case ViewerTypeVector:
+		elemTag, err := t.TypeArgs[0].LcsTag()
+		if err != nil {
+			return nil, err
+		}
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsVector, VectorType: elemTag}, nil
+	case ViewerTypeStruct:
+		structIdent, err := t.StructIdent()
+		if err != nil {
+			return nil, err
+		}
+		return &vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsStruct, StructIdent: structIdent}, nil
+	}
+
+	return nil, fmt.Errorf("%s: LCS type tag is not supported", t.String())
+}
+
+// NewMoveTypeFromLcsTag converts DVM LCS type tag to MoveType (used to decode VM events data).
+func NewMoveTypeFromLcsTag(tag *vm_grpc.LcsTag) (MoveType, error) {
+	if tag == nil {
+		return MoveType{}, fmt.Errorf("LCS type tag: nil")
+	}
+
+	switch tag.TypeTag {
+	case vm_grpc.LcsType_LcsU8:
+		return MoveType{Kind: ViewerTypeU8}, nil
+	case vm_grpc.LcsType_LcsU64:
+		return MoveType{Kind: ViewerTypeU64}, nil
+	case vm_grpc.LcsType_LcsU128:
+		return MoveType{Kind: ViewerTypeU128}, nil
+	case vm_grpc.LcsType_LcsBool:
+		return MoveType{Kind: ViewerTypeBool}, nil
+	case vm_grpc.LcsType_LcsAddress:
+		return MoveType{Kind: ViewerTypeAddress}, nil
+	case vm_grpc.LcsType_LcsSigner:
+		return MoveType{Kind: ViewerTypeSigner}, nil
+	case vm_grpc.LcsType_LcsVector:
+		elemType, err := NewMoveTypeFromLcsTag(tag.VectorType)
+		if err != nil {
+			return MoveType{}, fmt.Errorf("vector: %w", err)
+		}
+		return MoveType{Kind: ViewerTypeVector, TypeArgs: []MoveType{elemType}}, nil
+	case vm_grpc.LcsType_LcsStruct:
+		if tag.StructIdent == nil {
+			return MoveType{}, fmt.Errorf("struct: StructIdent is nil")
+		}
+
+		t := MoveType{
+			Kind:    ViewerTypeStruct,
+			Address: tag.StructIdent.Address,
+			Module:  tag.StructIdent.Module,
+			Name:    tag.StructIdent.Name,
+		}
+		for i, paramTag := range tag.StructIdent.TypeParams {
+			paramType, err := NewMoveTypeFromLcsTag(paramTag)
+			if err != nil {
+				return MoveType{}, fmt.Errorf("%s::%s: type param %d: %w", t.Module, t.Name, i, err)
+			}
+			t.TypeArgs = append(t.TypeArgs, paramType)
+		}
+		return t, nil
+	}
+
+	return MoveType{}, fmt.Errorf("LCS type tag %v: not supported", tag.TypeTag)
+}
+
+// IsConcrete checks that type doesn't contain type parameters.
+func (t MoveType) IsConcrete() bool {
+	if t.IsParam() {
+		return false
+	}
+
+	for _, arg := range t.TypeArgs {
+		if !arg.IsConcrete() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseMoveType parses Move type string.
+// {defaultAddress} is used for structs without module address ("Module::Struct"), could be nil.
+func ParseMoveType(typeStr string, defaultAddress []byte) (MoveType, error) {
+	p := moveTypeParser{input: typeStr, defaultAddress: defaultAddress}
+
+	t, err := p.parseType()
+	if err != nil {
+		return MoveType{}, fmt.Errorf("parsing Move type %q: %w", typeStr, err)
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return MoveType{}, fmt.Errorf("parsing Move type %q: unexpected %q at %d", typeStr, p.input[p.pos:], p.pos)
+	}
+
+	return t, nil
+}
+
+// moveTypeParser is a recursive descent Move type string parser.
+type moveTypeParser struct {
+	input          string
+	pos            int
+	defaultAddress []byte
+}
+
+var movePrimitiveTypes = map[string]ViewerType{
+	"u8":      ViewerTypeU8,
+	"u16":     ViewerTypeU16,
+	"u32":     ViewerTypeU32,
+	"u64":     ViewerTypeU64,
+	"u128":    ViewerTypeU128,
+	"u256":    ViewerTypeU256,
+	"bool":    ViewerTypeBool,
+	"address": ViewerTypeAddress,
+	"signer":  ViewerTypeSigner,
+}
+
+func (p *moveTypeParser) parseType() (MoveType, error) {
+	p.skipSpaces()
+
+	segments := []string{p.readIdent()}
+	for strings.HasPrefix(p.input[p.pos:], "::") {
+		p.pos += 2
+		segments = append(segments, p.readIdent())
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return MoveType{}, fmt.Errorf("empty identifier at %d", p.pos)
+		}
+	}
+
+	typeArgs, err := p.parseTypeArgs()
+	if err != nil {
+		return MoveType{}, err
+	}
+
+	switch len(segments) {
+	case 1:
+		name := segments[0]
+		if kind, ok := movePrimitiveTypes[strings.ToLower(name)]; ok {
+			if len(typeArgs) != 0 {
+				return MoveType{}, fmt.Errorf("%s: unexpected type arguments", name)
+			}
+			return MoveType{Kind: kind}, nil
+		}
+
+		if strings.ToLower(name) == "vector" {
+			if len(typeArgs) != 1 {
+				return MoveType{}, fmt.Errorf("vector: one type argument expected")
+			}
+			return MoveType{Kind: ViewerTypeVector, TypeArgs: typeArgs}, nil
+		}
+
+		if len(typeArgs) != 0 {
+			return MoveType{}, fmt.Errorf("%s: unexpected type arguments for type parameter", name)
+		}
+
+		return MoveType{Name: name}, nil
+	case 2:
+		if p.defaultAddress == nil {
+			return MoveType{}, fmt.Errorf("%s::%s: module address expected", segments[0], segments[1])
+		}
+
+		return MoveType{Kind: ViewerTypeStruct, Address: p.defaultAddress, Module: segments[0], Name: segments[1], TypeArgs: typeArgs}, nil
+	case 3:
+		address, err := parseMoveAddress(segments[0])
+		if err != nil {
+			return MoveType{}, err
+		}
+
+		return MoveType{Kind: ViewerTypeStruct, Address: address, Module: segments[1], Name: segments[2], TypeArgs: typeArgs}, nil
+	}
+
+	return MoveType{}, fmt.Errorf("%s: too many path segments", strings.Join(segments, "::"))
+}
+
+func (p *moveTypeParser) parseTypeArgs() ([]MoveType, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || p.input[p.pos] != '<' {
+		return nil, nil
+	}
+	p.pos++
+
+	var args []MoveType
+	for {
+		arg, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("unclosed type arguments")
+		}
+
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case '>':
+			p.pos++
+			return args, nil
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d", p.input[p.pos], p.pos)
+		}
+	}
+}
+
+func (p *moveTypeParser) readIdent() string {
+	p.skipSpaces()
+
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+		break
+	}
+
+	return p.input[start:p.pos]
+}
+
+func (p *moveTypeParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// parseMoveAddress parses 0x-prefixed HEX address (short form is left-padded with zeros) or Bech32 address.
+func parseMoveAddress(addrStr string) ([]byte, error) {
+	if !strings.HasPrefix(addrStr, "0x") {
+		accAddr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("address %q: 0x prefixed HEX / Bech32 expected: %w", addrStr, err)
+		}
+
+		return common_vm.Bech32ToLibra(accAddr), nil
+	}
+
+	hexStr := addrStr[2:]
+	if len(hexStr) > common_vm.VMAddressLength*2 {
+		return nil, fmt.Errorf("address %q: too long", addrStr)
+	}
+	hexStr = strings.Repeat("0", common_vm.VMAddressLength*2-len(hexStr)) + hexStr
+
+	address, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, fmt.Errorf("address %q: HEX decode: %w", addrStr, err)
+	}
+
+	return address, nil
+}
@@ -0,0 +1,96 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/stretchr/testify/require"
+)
+
+// Test ParseMoveType.
+func TestVM_ParseMoveType(t *testing.T) {
+	defAddr := make([]byte, 20)
+	defAddr[19] = 0x2
+	stdAddr := make([]byte, 20)
+	stdAddr[19] = 0x1
+
+	// ok: primitives
+	for str, kind := range map[string]ViewerType{
+		"u8": ViewerTypeU8, "u16": ViewerTypeU16, "u32": ViewerTypeU32, "u64": ViewerTypeU64,
+		"u128": ViewerTypeU128, "u256": ViewerTypeU256, "bool": ViewerTypeBool, "address": ViewerTypeAddress, "signer": ViewerTypeSigner,
+	} {
+		moveType, err := ParseMoveType(str, nil)
+		require.NoError(t, err, str)
+		require.Equal(t, kind, moveType.Kind, str)
+		require.Equal(t, str, moveType.String())
+	}
+
+	// ok: vector of type param
+	{
+		moveType, err := ParseMoveType("vector<T>", nil)
+		require.NoError(t, err)
+		require.Equal(t, ViewerTypeVector, moveType.Kind)
+		require.True(t, moveType.TypeArgs[0].IsParam())
+		require.Equal(t, "T", moveType.TypeArgs[0].Name)
+	}
+
+	// ok: struct with type args
+	{
+		moveType, err := ParseMoveType("0x1::Option::Option< Coins::ETH >", defAddr)
+		require.NoError(t, err)
+		require.True(t, moveType.IsOption())
+		require.Equal(t, stdAddr, moveType.Address)
+		require.Len(t, moveType.TypeArgs, 1)
+		require.Equal(t, defAddr, moveType.TypeArgs[0].Address)
+		require.Equal(t, "Coins", moveType.TypeArgs[0].Module)
+		require.Equal(t, "ETH", moveType.TypeArgs[0].Name)
+
+		_, err = moveType.StructTag()
+		require.NoError(t, err)
+	}
+
+	// fail: invalid inputs
+	for _, str := range []string{"", "vector<u8", "vector", "u64<u8>", "Coins::ETH", "0x1::A::B::C", "1::Coins::ETH", "vector<u8>>"} {
+		_, err := ParseMoveType(str, nil)
+		require.Error(t, err, str)
+	}
+
+	// fail: u16 type param is not supported by VM path
+	{
+		moveType, err := ParseMoveType("0x1::Coins::Balance<u16>", nil)
+		require.NoError(t, err)
+
+		_, err = moveType.StructTag()
+		require.Error(t, err)
+	}
+}
+
+// Test NewMoveTypeFromLcsTag.
+func TestVM_NewMoveTypeFromLcsTag(t *testing.T) {
+	// ok: round trip
+	for _, str := range []string{"u8", "u64", "u128", "bool", "address", "signer", "vector<vector<u8>>", "0x1::Coins::Balance<0x1::Coins::ETH>"} {
+		moveType, err := ParseMoveType(str, nil)
+		require.NoError(t, err, str)
+
+		tag, err := moveType.LcsTag()
+		require.NoError(t, err, str)
+
+		rcvType, err := NewMoveTypeFromLcsTag(tag)
+		require.NoError(t, err, str)
+		require.Equal(t, moveType.String(), rcvType.String(), str)
+	}
+
+	// fail: invalid tags
+	{
+		_, err := NewMoveTypeFromLcsTag(nil)
+		require.Error(t, err)
+
+		_, err = NewMoveTypeFromLcsTag(&vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsVector})
+		require.Error(t, err)
+
+		_, err = NewMoveTypeFromLcsTag(&vm_grpc.LcsTag{TypeTag: vm_grpc.LcsType_LcsStruct})
+		require.Error(t, err)
+	}
+}
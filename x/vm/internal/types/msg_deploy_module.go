@@ -13,6 +13,8 @@ var _ sdk.Msg = MsgDeployModule{}
 type MsgDeployModule struct {
 	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
 	Module []Contract     `json:"module" yaml:"module"`
+	// Optional modules source URL / hash (stored to the published modules registry)
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
 }
 
 // Implements sdk.Msg interface.
@@ -35,6 +37,10 @@ func (msg MsgDeployModule) ValidateBasic() error {
 		return ErrEmptyContract
 	}
 
+	if len(msg.Source) > ModuleSourceMaxLength {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "source: max length exceeded (%d)", ModuleSourceMaxLength)
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,4 +40,8 @@ func TestVM_MsgDeployModule(t *testing.T) {
 	msg = NewMsgDeployModule(acc, []Contract{})
 	require.Empty(t, msg.Module)
 	utils.CheckExpectedErr(t, ErrEmptyContract, msg.ValidateBasic())
+
+	msg = NewMsgDeployModule(acc, []Contract{code})
+	msg.Source = strings.Repeat("a", ModuleSourceMaxLength+1)
+	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidRequest, msg.ValidateBasic())
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -16,6 +18,10 @@ type MsgExecuteScript struct {
 	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
 	Script Contract       `json:"script" yaml:"script"`
 	Args   []ScriptArg    `json:"args" yaml:"args"`
+	// Script generic type params (Move struct types like 0x1::Coins::ETH)
+	TypeParams []string `json:"type_params,omitempty" yaml:"type_params,omitempty"`
+	// Additional script signers (script senders order: Signer, CoSigners...)
+	CoSigners []sdk.AccAddress `json:"co_signers,omitempty" yaml:"co_signers,omitempty"`
 }
 
 // Implements sdk.Msg interface.
@@ -34,6 +40,17 @@ func (msg MsgExecuteScript) ValidateBasic() error {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "empty deployer address")
 	}
 
+	signersSet := map[string]bool{msg.Signer.String(): true}
+	for i, coSigner := range msg.CoSigners {
+		if coSigner.Empty() {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "co_signers[%d]: empty address", i)
+		}
+		if signersSet[coSigner.String()] {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "co_signers[%d]: duplicated signer %s", i, coSigner)
+		}
+		signersSet[coSigner.String()] = true
+	}
+
 	if len(msg.Script) == 0 {
 		return ErrEmptyContract
 	}
@@ -47,9 +64,34 @@ func (msg MsgExecuteScript) ValidateBasic() error {
 		}
 	}
 
+	if _, err := msg.ParseTypeParams(); err != nil {
+		return sdkErrors.Wrap(ErrWrongTypeParam, err.Error())
+	}
+
 	return nil
 }
 
+// ParseTypeParams parses and validates script type params.
+func (msg MsgExecuteScript) ParseTypeParams() ([]MoveType, error) {
+	typeParams := make([]MoveType, 0, len(msg.TypeParams))
+	for i, typeParamStr := range msg.TypeParams {
+		typeParam, err := ParseMoveType(typeParamStr, nil)
+		if err != nil {
+			return nil, fmt.Errorf("type_params[%d]: %w", i, err)
+		}
+		if typeParam.Kind != ViewerTypeStruct || !typeParam.IsConcrete() {
+			return nil, fmt.Errorf("type_params[%d]: %s: concrete struct type expected", i, typeParamStr)
+		}
+		if _, err := typeParam.StructIdent(); err != nil {
+			return nil, fmt.Errorf("type_params[%d]: %w", i, err)
+		}
+
+		typeParams = append(typeParams, typeParam)
+	}
+
+	return typeParams, nil
+}
+
 // Implements sdk.Msg interface.
 func (msg MsgExecuteScript) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -57,7 +99,11 @@ func (msg MsgExecuteScript) GetSignBytes() []byte {
 
 // Implements sdk.Msg interface.
 func (msg MsgExecuteScript) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Signer}
+	signers := make([]sdk.AccAddress, 0, 1+len(msg.CoSigners))
+	signers = append(signers, msg.Signer)
+	signers = append(signers, msg.CoSigners...)
+
+	return signers
 }
 
 // NewMsgExecuteScript creates a new MsgExecuteScript message.
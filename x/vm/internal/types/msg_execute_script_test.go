@@ -52,6 +52,33 @@ func TestVM_MsgExecuteScript(t *testing.T) {
 	// script without code
 	msg = NewMsgExecuteScript(acc, []byte{}, nil)
 	utils.CheckExpectedErr(t, ErrEmptyContract, msg.ValidateBasic())
+
+	// script with type params
+	msg = NewMsgExecuteScript(acc, code, nil)
+	msg.TypeParams = []string{"0x1::Coins::ETH", "0x1::Dfinance::T<0x1::Coins::BTC>"}
+	require.NoError(t, msg.ValidateBasic())
+	typeParams, err := msg.ParseTypeParams()
+	require.NoError(t, err)
+	require.Len(t, typeParams, 2)
+
+	// script with invalid type params
+	for _, typeParam := range []string{"u64", "Coins::ETH", "0x1::Coins::Balance<T>", "0x1::Coins::Balance<u16>", "0x1::Coins::"} {
+		msg.TypeParams = []string{typeParam}
+		utils.CheckExpectedErr(t, ErrWrongTypeParam, msg.ValidateBasic())
+	}
+
+	// script with co-signers
+	acc2, acc3 := sdk.AccAddress([]byte("addr2")), sdk.AccAddress([]byte("addr3"))
+	msg = NewMsgExecuteScript(acc, code, nil)
+	msg.CoSigners = []sdk.AccAddress{acc2, acc3}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{acc, acc2, acc3}, msg.GetSigners())
+
+	// script with invalid co-signers
+	for _, coSigners := range [][]sdk.AccAddress{{acc2, {}}, {acc2, acc2}, {acc}} {
+		msg.CoSigners = coSigners
+		utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+	}
 }
 
 // Test new argument
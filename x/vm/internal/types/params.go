@@ -0,0 +1,189 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameters values.
+const (
+	DefaultGasUnitPrice      uint64 = 1
+	DefaultGasMultiplier     uint64 = 1
+	DefaultMaxGas                   = VMMaxGasLimit
+	DefaultPublishGasPerByte uint64 = 0
+	//
+	VMMaxGasLimit    = math.MaxUint64/MaxGasMultiplier - 1
+	MaxGasMultiplier = 1000
+)
+
+// Parameter store key.
+var (
+	ParamStoreKeyGasUnitPrice      = []byte("gasUnitPrice")
+	ParamStoreKeyGasMultiplier     = []byte("gasMultiplier")
+	ParamStoreKeyMaxGas            = []byte("maxGas")
+	ParamStoreKeyPublishGasPerByte = []byte("publishGasPerByte")
+)
+
+// Params defines VM gas schedule params.
+type Params struct {
+	// VM gas unit price passed to DVM
+	GasUnitPrice uint64 `json:"gas_unit_price" yaml:"gas_unit_price"`
+	// VM gas to SDK gas multiplier
+	GasMultiplier uint64 `json:"gas_multiplier" yaml:"gas_multiplier"`
+	// Max VM gas per script execution / module publish
+	MaxGas uint64 `json:"max_gas" yaml:"max_gas"`
+	// SDK gas charged per published module bytecode byte
+	PublishGasPerByte uint64 `json:"publish_gas_per_byte" yaml:"publish_gas_per_byte"`
+}
+
+// Implements subspace.ParamSet interface.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: ParamStoreKeyGasUnitPrice, Value: &p.GasUnitPrice, ValidatorFn: validateGasUnitPrice},
+		{Key: ParamStoreKeyGasMultiplier, Value: &p.GasMultiplier, ValidatorFn: validateGasMultiplier},
+		{Key: ParamStoreKeyMaxGas, Value: &p.MaxGas, ValidatorFn: validateMaxGas},
+		{Key: ParamStoreKeyPublishGasPerByte, Value: &p.PublishGasPerByte, ValidatorFn: validatePublishGasPerByte},
+	}
+}
+
+// Equal checks params equality.
+func (p Params) Equal(p2 Params) bool {
+	return p == p2
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateGasUnitPrice(p.GasUnitPrice); err != nil {
+		return err
+	}
+	if err := validateGasMultiplier(p.GasMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxGas(p.MaxGas); err != nil {
+		return err
+	}
+	if err := validatePublishGasPerByte(p.PublishGasPerByte); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetVMMaxGas converts SDK gas limit to VM max gas amount (limited by the MaxGas param).
+func (p Params) GetVMMaxGas(sdkGas uint64) uint64 {
+	vmGas := sdkGas
+	if p.GasMultiplier > 1 {
+		vmGas = sdkGas / p.GasMultiplier
+	}
+
+	if vmGas > p.MaxGas {
+		return p.MaxGas
+	}
+
+	return vmGas
+}
+
+// GetSDKGas converts VM gas used to SDK gas (math.MaxUint64 on overflow).
+func (p Params) GetSDKGas(vmGas uint64) uint64 {
+	if p.GasMultiplier <= 1 {
+		return vmGas
+	}
+
+	if vmGas > math.MaxUint64/p.GasMultiplier {
+		return math.MaxUint64
+	}
+
+	return vmGas * p.GasMultiplier
+}
+
+// GetPublishGas returns SDK gas charged for the module bytecode {codeLen} (math.MaxUint64 on overflow).
+func (p Params) GetPublishGas(codeLen int) uint64 {
+	if codeLen <= 0 || p.PublishGasPerByte == 0 {
+		return 0
+	}
+
+	if uint64(codeLen) > math.MaxUint64/p.PublishGasPerByte {
+		return math.MaxUint64
+	}
+
+	return uint64(codeLen) * p.PublishGasPerByte
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf("Params:\n"+
+		"  GasUnitPrice: %d\n"+
+		"  GasMultiplier: %d\n"+
+		"  MaxGas: %d\n"+
+		"  PublishGasPerByte: %d",
+		p.GasUnitPrice,
+		p.GasMultiplier,
+		p.MaxGas,
+		p.PublishGasPerByte,
+	)
+}
+
+// NewParams creates a new module Params.
+func NewParams(gasUnitPrice, gasMultiplier, maxGas, publishGasPerByte uint64) Params {
+	return Params{
+		GasUnitPrice:      gasUnitPrice,
+		GasMultiplier:     gasMultiplier,
+		MaxGas:            maxGas,
+		PublishGasPerByte: publishGasPerByte,
+	}
+}
+
+// DefaultParams returns default module params.
+func DefaultParams() Params {
+	return NewParams(DefaultGasUnitPrice, DefaultGasMultiplier, DefaultMaxGas, DefaultPublishGasPerByte)
+}
+
+// ParamKeyTable returns Key declaration for parameters storage.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func validateGasUnitPrice(value interface{}) error {
+	v, ok := value.(uint64)
+	if !ok {
+		return fmt.Errorf("gas_unit_price: invalid type: %T", value)
+	}
+	if v == 0 {
+		return fmt.Errorf("gas_unit_price: should be GT 0")
+	}
+
+	return nil
+}
+
+func validateGasMultiplier(value interface{}) error {
+	v, ok := value.(uint64)
+	if !ok {
+		return fmt.Errorf("gas_multiplier: invalid type: %T", value)
+	}
+	if v == 0 || v > MaxGasMultiplier {
+		return fmt.Errorf("gas_multiplier: should be in [1:%d] range", MaxGasMultiplier)
+	}
+
+	return nil
+}
+
+func validateMaxGas(value interface{}) error {
+	v, ok := value.(uint64)
+	if !ok {
+		return fmt.Errorf("max_gas: invalid type: %T", value)
+	}
+	if v == 0 || v > VMMaxGasLimit {
+		return fmt.Errorf("max_gas: should be in [1:%d] range", uint64(VMMaxGasLimit))
+	}
+
+	return nil
+}
+
+func validatePublishGasPerByte(value interface{}) error {
+	if _, ok := value.(uint64); !ok {
+		return fmt.Errorf("publish_gas_per_byte: invalid type: %T", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// +build unit
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVM_Params_Validate(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, NewParams(1, MaxGasMultiplier, 1, math.MaxUint64).Validate())
+
+	// fail: gas unit price
+	require.Error(t, NewParams(0, 1, 1000, 0).Validate())
+
+	// fail: gas multiplier
+	require.Error(t, NewParams(1, 0, 1000, 0).Validate())
+	require.Error(t, NewParams(1, MaxGasMultiplier+1, 1000, 0).Validate())
+
+	// fail: max gas
+	require.Error(t, NewParams(1, 1, 0, 0).Validate())
+	require.Error(t, NewParams(1, 1, VMMaxGasLimit+1, 0).Validate())
+}
+
+func TestVM_Params_Gas(t *testing.T) {
+	t.Parallel()
+
+	// VM max gas
+	{
+		params := NewParams(1, 4, 1000, 0)
+		require.EqualValues(t, 250, params.GetVMMaxGas(1000))
+		require.EqualValues(t, 1000, params.GetVMMaxGas(100000))
+		require.EqualValues(t, VMMaxGasLimit, DefaultParams().GetVMMaxGas(math.MaxUint64))
+	}
+
+	// SDK gas
+	{
+		params := NewParams(1, 4, 1000, 0)
+		require.EqualValues(t, 400, params.GetSDKGas(100))
+		require.EqualValues(t, uint64(math.MaxUint64), params.GetSDKGas(math.MaxUint64/2))
+		require.EqualValues(t, 100, DefaultParams().GetSDKGas(100))
+	}
+
+	// publish gas
+	{
+		params := NewParams(1, 1, 1000, 3)
+		require.EqualValues(t, 300, params.GetPublishGas(100))
+		require.EqualValues(t, 0, params.GetPublishGas(0))
+		require.EqualValues(t, 0, DefaultParams().GetPublishGas(100))
+
+		params.PublishGasPerByte = math.MaxUint64
+		require.EqualValues(t, uint64(math.MaxUint64), params.GetPublishGas(2))
+	}
+}
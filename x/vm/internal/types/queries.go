@@ -1,10 +1,54 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryValue      = "value"
+	QueryLcsView    = "lcsView"
+	QuerySimulate   = "simulate"
+	QueryTxWriteSet = "tx_writeset"
+	QueryEvents     = "events"
+	QueryResources  = "resources"
+	QueryModules    = "modules"
+	QueryModule     = "module"
+	QueryProposals  = "proposals"
+	QueryParams     = "params"
+)
+
 const (
-	QueryValue   = "value"
-	QueryLcsView = "lcsView"
+	// Max items per page for paginated queries
+	QueryMaxLimit = 1000
 )
 
+// validatePagination checks paginated query page (first page: 1) and limit.
+func validatePagination(page, limit sdk.Uint) error {
+	if page == (sdk.Uint{}) {
+		return fmt.Errorf("page: nil")
+	}
+	if limit == (sdk.Uint{}) {
+		return fmt.Errorf("limit: nil")
+	}
+	if page.IsZero() {
+		return fmt.Errorf("page: is zero")
+	}
+	if limit.IsZero() {
+		return fmt.Errorf("limit: is zero")
+	}
+	if limit.GT(sdk.NewUint(QueryMaxLimit)) {
+		return fmt.Errorf("limit: max %d exceeded", QueryMaxLimit)
+	}
+	if endIdx := new(big.Int).Mul(page.BigInt(), limit.BigInt()); !endIdx.IsUint64() {
+		return fmt.Errorf("page: out of range")
+	}
+
+	return nil
+}
+
 // Client request for writeSet data.
 type ValueReq struct {
 	Address []byte `json:"address" yaml:"address"`
@@ -12,11 +56,17 @@ type ValueReq struct {
 }
 
 // Client request for LCS view writeSet data.
+// If {ViewRequest} is empty, it is built using the module metadata ({StructName} is required).
 type LcsViewReq struct {
 	Address     []byte        `json:"address" yaml:"address"`
 	ModuleName  string        `json:"module_name" yaml:"module_name"`
 	StructName  string        `json:"struct_name" yaml:"struct_name"`
+	TypeParams  []string      `json:"type_params" yaml:"type_params"`
 	ViewRequest ViewerRequest `json:"view_request" yaml:"view_request"`
+	// Default format for vector<u8> fields
+	BytesFormat ViewerFormat `json:"bytes_format" yaml:"bytes_format"`
+	// Default format for address fields
+	AddressFormat ViewerFormat `json:"address_format" yaml:"address_format"`
 }
 
 // Client response for writeSet data.
@@ -27,3 +77,8 @@ type ValueResp struct {
 func (resp ValueResp) String() string {
 	return "Value: " + resp.Value
 }
+
+// Client request for transaction writeSets summary.
+type TxWriteSetReq struct {
+	Hash []byte `json:"hash" yaml:"hash"`
+}
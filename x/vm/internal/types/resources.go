@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	ResourceTypeModule   = "module"
+	ResourceTypeResource = "resource"
+	// Max number of resources counted for the response total (address values iteration limit)
+	ResourcesMaxTotal = 10000
+)
+
+// Client request for account VM resources.
+type ResourcesReq struct {
+	// VM address
+	Address []byte `json:"address" yaml:"address"`
+	// Page number (first page: 1)
+	Page sdk.Uint `json:"page" yaml:"page"`
+	// Items per page
+	Limit sdk.Uint `json:"limit" yaml:"limit"`
+}
+
+// Validate checks request address and pagination.
+func (r ResourcesReq) Validate() error {
+	if len(r.Address) != common_vm.VMAddressLength {
+		return fmt.Errorf("address: invalid length: %d", len(r.Address))
+	}
+	if err := validatePagination(r.Page, r.Limit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Resource is a VM writeSet (module / resource) stored under the account address.
+type Resource struct {
+	// VM path (HEX string)
+	Path string `json:"path" yaml:"path"`
+	// Resource type (module / resource)
+	Type string `json:"type" yaml:"type"`
+	// Decoded Move name: 0x{address}::{module} for module and struct tag for resource (empty if unknown)
+	Name string `json:"name" yaml:"name"`
+	// Value length [bytes]
+	Size int `json:"size" yaml:"size"`
+}
+
+func (r Resource) String() string {
+	name := r.Name
+	if name == "" {
+		name = "unknown"
+	}
+
+	return fmt.Sprintf("%s %s: %s (%d bytes)", r.Type, r.Path, name, r.Size)
+}
+
+// Client response for account VM resources.
+type Resources struct {
+	// Total number of resources stored under the address (counted up to the {ResourcesMaxTotal} or the page end)
+	Total uint64 `json:"total" yaml:"total"`
+	// Total is not exact as counting was stopped
+	TotalCapped bool `json:"total_capped" yaml:"total_capped"`
+	// Page items
+	Items []Resource `json:"items" yaml:"items"`
+}
+
+func (r Resources) String() string {
+	b := strings.Builder{}
+	if r.TotalCapped {
+		b.WriteString(fmt.Sprintf("Total: %d+\n", r.Total))
+	} else {
+		b.WriteString(fmt.Sprintf("Total: %d\n", r.Total))
+	}
+	for _, item := range r.Items {
+		b.WriteString("  " + item.String() + "\n")
+	}
+
+	return b.String()
+}
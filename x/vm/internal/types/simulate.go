@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	WriteSetOpSet    = "set"
+	WriteSetOpDelete = "delete"
+)
+
+// Client request for VM message simulation (one of messages must be set).
+type SimulateReq struct {
+	ExecuteScript *MsgExecuteScript `json:"execute_script,omitempty" yaml:"execute_script,omitempty"`
+	DeployModule  *MsgDeployModule  `json:"deploy_module,omitempty" yaml:"deploy_module,omitempty"`
+}
+
+// Validate validates request object.
+func (req SimulateReq) Validate() error {
+	switch {
+	case req.ExecuteScript != nil && req.DeployModule != nil:
+		return sdkErrors.Wrap(ErrInternal, "only one message must be set")
+	case req.ExecuteScript != nil:
+		return req.ExecuteScript.ValidateBasic()
+	case req.DeployModule != nil:
+		return req.DeployModule.ValidateBasic()
+	}
+
+	return sdkErrors.Wrap(ErrInternal, "message not set")
+}
+
+// WriteSetDiff is a VM writeSet operation with the previous storage value.
+type WriteSetDiff struct {
+	// Operation type (set / delete)
+	Op string `json:"op" yaml:"op"`
+	// VM address (HEX string)
+	Address string `json:"address" yaml:"address"`
+	// VM path (HEX string)
+	Path string `json:"path" yaml:"path"`
+	// Storage value before the operation (HEX string), empty if not exists
+	OldValue string `json:"old_value,omitempty" yaml:"old_value,omitempty"`
+	// Storage value after the operation (HEX string), empty for delete
+	NewValue string `json:"new_value,omitempty" yaml:"new_value,omitempty"`
+}
+
+func (diff WriteSetDiff) String() string {
+	return fmt.Sprintf("WriteSetDiff:\n"+
+		"  Op: %s\n"+
+		"  Address: %s\n"+
+		"  Path: %s\n"+
+		"  OldValue: %s\n"+
+		"  NewValue: %s",
+		diff.Op, diff.Address, diff.Path, diff.OldValue, diff.NewValue,
+	)
+}
+
+// WriteSetDiffs is a slice of WriteSetDiff objects.
+type WriteSetDiffs []WriteSetDiff
+
+func (list WriteSetDiffs) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("WriteSetDiffs:\n")
+	for i, diff := range list {
+		strBuilder.WriteString(diff.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// Client response for VM message simulation.
+type SimulateResp struct {
+	// Gas used by VM execution(s)
+	GasUsed uint64 `json:"gas_used" yaml:"gas_used"`
+	// VM execution status per request (one per script / module)
+	VMStatuses VMStatuses `json:"vm_statuses" yaml:"vm_statuses"`
+	// WriteSet changes (not persisted)
+	WriteSet WriteSetDiffs `json:"write_set" yaml:"write_set"`
+	// Emitted events
+	Events sdk.StringEvents `json:"events" yaml:"events"`
+}
+
+func (resp SimulateResp) String() string {
+	return fmt.Sprintf("Simulation:\n"+
+		"  GasUsed: %d\n"+
+		"  %s\n"+
+		"  %s\n"+
+		"  Events: %s",
+		resp.GasUsed, resp.VMStatuses.String(), resp.WriteSet.String(), resp.Events.String(),
+	)
+}
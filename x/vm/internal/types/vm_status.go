@@ -3,9 +3,11 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 )
 
 const (
@@ -249,6 +251,22 @@ func NewVMStatus(status, majorCode, subCode, message string) VMStatus {
 	}
 }
 
+// NewVMStatusFromExecStatus converts VM execution status to VMStatus.
+func NewVMStatusFromExecStatus(status *vm_grpc.VMStatus) VMStatus {
+	if status.GetError() == nil {
+		return NewVMStatus(AttributeValueStatusKeep, "", "", "")
+	}
+
+	majorStatus, subStatus, _ := GetStatusCodesFromVMStatus(status)
+
+	return NewVMStatus(
+		AttributeValueStatusDiscard,
+		strconv.FormatUint(majorStatus, 10),
+		strconv.FormatUint(subStatus, 10),
+		status.GetMessage().GetText(),
+	)
+}
+
 // Slice of VMStatus objects (VM error responses).
 type VMStatuses []VMStatus
 
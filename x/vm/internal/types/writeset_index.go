@@ -0,0 +1,93 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+)
+
+var (
+	// Transient storage prefix for the current block writeSets (tx hash -> items)
+	TxWriteSetPrefix = []byte("tx_writeset")
+)
+
+// GetTxWriteSetKey returns transient storage key for transaction writeSets summary.
+func GetTxWriteSetKey(txHash []byte) []byte {
+	return append(append([]byte{}, TxWriteSetPrefix...), txHash...)
+}
+
+// WriteSetIndexItem is a VM writeSet operation summary.
+type WriteSetIndexItem struct {
+	// Operation type (set / delete)
+	Op string `json:"op" yaml:"op"`
+	// VM address (HEX string)
+	Address string `json:"address" yaml:"address"`
+	// VM path (HEX string)
+	Path string `json:"path" yaml:"path"`
+	// Written value SHA256 hash (HEX string), empty for delete
+	ValueHash string `json:"value_hash,omitempty" yaml:"value_hash,omitempty"`
+}
+
+func (item WriteSetIndexItem) String() string {
+	return fmt.Sprintf("WriteSetIndexItem:\n"+
+		"  Op: %s\n"+
+		"  Address: %s\n"+
+		"  Path: %s\n"+
+		"  ValueHash: %s",
+		item.Op, item.Address, item.Path, item.ValueHash,
+	)
+}
+
+// NewWriteSetIndexItem creates a new WriteSetIndexItem object.
+func NewWriteSetIndexItem(value *vm_grpc.VMValue) WriteSetIndexItem {
+	item := WriteSetIndexItem{
+		Op:      WriteSetOpSet,
+		Address: hex.EncodeToString(value.Path.Address),
+		Path:    hex.EncodeToString(value.Path.Path),
+	}
+
+	if value.Type == vm_grpc.VmWriteOp_Deletion {
+		item.Op = WriteSetOpDelete
+	} else {
+		item.ValueHash = HashWriteSetValue(value.Value)
+	}
+
+	return item
+}
+
+// HashWriteSetValue returns writeSet value SHA256 hash HEX string.
+func HashWriteSetValue(value []byte) string {
+	hash := sha256.Sum256(value)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// TxWriteSet is a transaction writeSets summary.
+type TxWriteSet struct {
+	// Transaction hash (HEX string)
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
+	// Block height
+	Height int64 `json:"height" yaml:"height"`
+	// WriteSet operations in the execution order
+	Items []WriteSetIndexItem `json:"items" yaml:"items"`
+}
+
+func (ws TxWriteSet) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString(fmt.Sprintf("TxWriteSet:\n"+
+		"  TxHash: %s\n"+
+		"  Height: %d\n",
+		ws.TxHash, ws.Height,
+	))
+	for i, item := range ws.Items {
+		strBuilder.WriteString(item.String())
+		if i < len(ws.Items)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
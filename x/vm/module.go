@@ -132,5 +132,8 @@ func (app AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock performs module actions at a block end.
 func (app AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	app.keeper.FlushWriteSetIndex(ctx)
+	app.keeper.FlushEventIndex(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
@@ -14,12 +14,18 @@ type (
 	Keeper       = keeper.VMAccountKeeper
 	GenesisState = authTypes.GenesisState
 	//
+	BalanceMismatch    = types.BalanceMismatch
+	BalanceMismatches  = types.BalanceMismatches
+	BalanceMismatchReq = types.BalanceMismatchReq
+	//
 	SquashOptions = keeper.SquashOptions
 )
 
 const (
 	ModuleName   = types.ModuleName
 	QuerierRoute = authTypes.QuerierRoute
+	//
+	QueryBalanceMismatch = types.QueryBalanceMismatch
 )
 
 var (
@@ -36,6 +42,7 @@ var (
 	DefaultGenesisState = authTypes.DefaultGenesisState
 	//
 	NewEmptySquashOptions = keeper.NewEmptySquashOptions
+	NewBalanceMismatch    = types.NewBalanceMismatch
 	// perms requests
 	RequestCCStoragePerms = types.RequestCCStoragePerms
 )
@@ -11,6 +11,7 @@ import (
 	codec "github.com/tendermint/go-amino"
 
 	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/vmauth/internal/types"
 )
 
 // GetAccountCmd returns a query cmd that return account state (same as std keeper query, but using VM balance resources).
@@ -57,3 +58,48 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// GetBalanceMismatchCmd returns a query cmd that compares account auth coins with VM balance resources.
+func GetBalanceMismatchCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-audit [address]",
+		Short: "Query account auth coins and VM balance resources mismatch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			addr, err := helpers.ParseSdkAddressParam("address", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.BalanceMismatchReq{
+				Address: addr,
+			}
+
+			bz, err := cdc.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", authTypes.QuerierRoute, types.QueryBalanceMismatch), bz)
+			if err != nil {
+				return err
+			}
+
+			var mismatch types.BalanceMismatch
+			if err := cdc.UnmarshalJSON(res, &mismatch); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(mismatch)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"account address",
+	})
+
+	return flags.GetCommands(cmd)[0]
+}
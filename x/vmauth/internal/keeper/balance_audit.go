@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+
+	"github.com/dfinance/dnode/x/vmauth/internal/types"
+)
+
+// GetBalanceMismatch compares account auth coins with VM balance resources (account is not synced).
+func (k VMAccountKeeper) GetBalanceMismatch(ctx sdk.Context, addr sdk.AccAddress) (types.BalanceMismatch, error) {
+	authCoins := sdk.NewCoins()
+	if acc := k.AccountKeeper.GetAccount(ctx, addr); acc != nil {
+		authCoins = acc.GetCoins()
+	}
+
+	balances, err := k.ccsKeeper.GetAccountBalanceResources(ctx, addr)
+	if err != nil {
+		return types.BalanceMismatch{}, fmt.Errorf("reading balance resources for %s: %w", addr, err)
+	}
+
+	return types.NewBalanceMismatch(addr, authCoins, balances.Coins()), nil
+}
+
+// GetBalanceMismatches returns all std keeper accounts with auth coins and VM balance resources mismatch.
+func (k VMAccountKeeper) GetBalanceMismatches(ctx sdk.Context) (types.BalanceMismatches, error) {
+	var retErr error
+	mismatches := make(types.BalanceMismatches, 0)
+
+	k.AccountKeeper.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+		mismatch, err := k.GetBalanceMismatch(ctx, acc.GetAddress())
+		if err != nil {
+			retErr = err
+			return true
+		}
+
+		if mismatch.Mismatch {
+			mismatches = append(mismatches, mismatch)
+		}
+
+		return false
+	})
+
+	return mismatches, retErr
+}
+
+// FixBalanceMismatch sets account auth coins and VM balance resources using one of them as a source.
+// Total supply is adjusted by the auth coins difference (supply keeper is passed as it is created after the account keeper).
+func (k VMAccountKeeper) FixBalanceMismatch(ctx sdk.Context, supplyKeeper types.SupplyKeeper, mismatch types.BalanceMismatch, useVMCoins bool) error {
+	acc := k.AccountKeeper.GetAccount(ctx, mismatch.Address)
+	if acc == nil {
+		acc = k.NewAccountWithAddress(ctx, mismatch.Address)
+	}
+
+	coins := mismatch.AuthCoins
+	if useVMCoins {
+		coins = mismatch.VMCoins
+	}
+	if err := acc.SetCoins(coins); err != nil {
+		return fmt.Errorf("setting coins for %s: %w", mismatch.Address, err)
+	}
+
+	// supply is adjusted directly as auth coins are changed without mint / burn
+	increase, decrease := mismatch.SupplyDiff(useVMCoins)
+	if !increase.IsZero() || !decrease.IsZero() {
+		supply := supplyKeeper.GetSupply(ctx)
+
+		total, negative := supply.GetTotal().Add(increase...).SafeSub(decrease)
+		if negative {
+			return fmt.Errorf("adjusting supply for %s: total supply %s can't be decreased by %s", mismatch.Address, supply.GetTotal(), decrease)
+		}
+		supplyKeeper.SetSupply(ctx, supply.SetTotal(total))
+	}
+
+	// VM resources and std keeper are updated directly to prevent supply change for new accounts
+	if err := k.ccsKeeper.SetAccountBalanceResources(ctx, acc); err != nil {
+		return fmt.Errorf("setting balance resources for %s: %w", mismatch.Address, err)
+	}
+	k.AccountKeeper.SetAccount(ctx, acc)
+
+	return nil
+}
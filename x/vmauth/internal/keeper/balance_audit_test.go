@@ -0,0 +1,135 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/x/vmauth/internal/types"
+)
+
+// testSupplyKeeper is an in-memory types.SupplyKeeper implementation.
+type testSupplyKeeper struct {
+	supply supplyExported.SupplyI
+}
+
+func (k *testSupplyKeeper) GetSupply(ctx sdk.Context) supplyExported.SupplyI {
+	return k.supply
+}
+
+func (k *testSupplyKeeper) SetSupply(ctx sdk.Context, supply supplyExported.SupplyI) {
+	k.supply = supply
+}
+
+// Test balance mismatch audit and fix.
+func TestVMAuthKeeper_BalanceMismatch(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.accountKeeper, input.ctx
+
+	vmCoins := sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(10)))
+	authCoins := sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(20)), sdk.NewCoin("eth", sdk.NewInt(5)))
+
+	// supply accounts auth coins as they are changed by breakAuthCoins
+	supplyKeeper := &testSupplyKeeper{supply: supply.NewSupply(authCoins)}
+
+	acc := input.CreateAccount(t, vmCoins)
+	keeper.SetAccount(ctx, acc)
+	addr := acc.GetAddress()
+
+	// breakAuthCoins changes auth coins without VM balance resources update
+	breakAuthCoins := func() {
+		stdAcc := keeper.AccountKeeper.GetAccount(ctx, addr)
+		require.NoError(t, stdAcc.SetCoins(authCoins))
+		keeper.AccountKeeper.SetAccount(ctx, stdAcc)
+	}
+
+	// ok: no mismatch
+	{
+		mismatch, err := keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.False(t, mismatch.Mismatch)
+
+		mismatches, err := keeper.GetBalanceMismatches(ctx)
+		require.NoError(t, err)
+		require.Empty(t, mismatches)
+	}
+
+	// ok: mismatch
+	{
+		breakAuthCoins()
+
+		mismatch, err := keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.True(t, mismatch.Mismatch)
+		require.True(t, authCoins.IsEqual(mismatch.AuthCoins))
+		require.True(t, vmCoins.IsEqual(mismatch.VMCoins))
+
+		mismatches, err := keeper.GetBalanceMismatches(ctx)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		require.Equal(t, addr, mismatches[0].Address)
+	}
+
+	// ok: querier
+	{
+		querier := NewQuerier(keeper)
+		reqBz, err := input.cdc.MarshalJSON(types.BalanceMismatchReq{Address: addr})
+		require.NoError(t, err)
+
+		resBz, err := querier(ctx, []string{types.QueryBalanceMismatch}, abci.RequestQuery{Data: reqBz})
+		require.NoError(t, err)
+
+		var mismatch types.BalanceMismatch
+		require.NoError(t, input.cdc.UnmarshalJSON(resBz, &mismatch))
+		require.True(t, mismatch.Mismatch)
+	}
+
+	// fail: fix using VM balances with insufficient supply
+	{
+		mismatch, err := keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+
+		lowSupplyKeeper := &testSupplyKeeper{supply: supply.NewSupply(vmCoins)}
+		require.Error(t, keeper.FixBalanceMismatch(ctx, lowSupplyKeeper, mismatch, true))
+		require.True(t, vmCoins.IsEqual(lowSupplyKeeper.supply.GetTotal()))
+	}
+
+	// ok: fix using VM balances
+	{
+		mismatch, err := keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.NoError(t, keeper.FixBalanceMismatch(ctx, supplyKeeper, mismatch, true))
+		require.True(t, vmCoins.IsEqual(supplyKeeper.supply.GetTotal()))
+
+		mismatch, err = keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.False(t, mismatch.Mismatch)
+		require.True(t, vmCoins.IsEqual(keeper.AccountKeeper.GetAccount(ctx, addr).GetCoins()))
+	}
+
+	// ok: fix using auth coins
+	{
+		breakAuthCoins()
+
+		mismatch, err := keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.NoError(t, keeper.FixBalanceMismatch(ctx, supplyKeeper, mismatch, false))
+		require.True(t, vmCoins.IsEqual(supplyKeeper.supply.GetTotal()))
+
+		mismatch, err = keeper.GetBalanceMismatch(ctx, addr)
+		require.NoError(t, err)
+		require.False(t, mismatch.Mismatch)
+
+		balances, err := input.ccsStorage.GetAccountBalanceResources(ctx, addr)
+		require.NoError(t, err)
+		require.True(t, authCoins.IsEqual(balances.Coins()))
+	}
+}
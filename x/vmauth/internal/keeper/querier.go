@@ -16,6 +16,8 @@ func NewQuerier(k VMAccountKeeper) sdk.Querier {
 		switch path[0] {
 		case authTypes.QueryAccount:
 			return queryAccount(ctx, req, k)
+		case types.QueryBalanceMismatch:
+			return queryBalanceMismatch(ctx, req, k)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -41,3 +43,23 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, k VMAccountKeeper) ([]
 
 	return bz, nil
 }
+
+// queryBalanceMismatch handles account auth coins and VM balance resources comparison query.
+func queryBalanceMismatch(ctx sdk.Context, req abci.RequestQuery, k VMAccountKeeper) ([]byte, error) {
+	var params types.BalanceMismatchReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	mismatch, err := k.GetBalanceMismatch(ctx, params.Address)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "%v", err)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, mismatch)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "BalanceMismatch marshal: %v", err)
+	}
+
+	return bz, nil
+}
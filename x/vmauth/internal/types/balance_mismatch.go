@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BalanceMismatch is an account auth coins and VM balance resources comparison result.
+type BalanceMismatch struct {
+	// Account address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Coins stored by the std auth keeper
+	AuthCoins sdk.Coins `json:"auth_coins" yaml:"auth_coins" swaggertype:"string" example:"100xfi"`
+	// Coins stored as ccstorage balance resources
+	VMCoins sdk.Coins `json:"vm_coins" yaml:"vm_coins" swaggertype:"string" example:"100xfi"`
+	// Auth and VM coins are not equal
+	Mismatch bool `json:"mismatch" yaml:"mismatch"`
+}
+
+func (m BalanceMismatch) String() string {
+	return fmt.Sprintf("BalanceMismatch:\n"+
+		"  Address:   %s\n"+
+		"  AuthCoins: %s\n"+
+		"  VMCoins:   %s\n"+
+		"  Mismatch:  %t",
+		m.Address,
+		m.AuthCoins,
+		m.VMCoins,
+		m.Mismatch,
+	)
+}
+
+// NewBalanceMismatch creates a new BalanceMismatch object.
+func NewBalanceMismatch(addr sdk.AccAddress, authCoins, vmCoins sdk.Coins) BalanceMismatch {
+	if authCoins == nil {
+		authCoins = sdk.NewCoins()
+	}
+	if vmCoins == nil {
+		vmCoins = sdk.NewCoins()
+	}
+
+	// sdk.Coins.IsEqual panics on denoms mismatch
+	equal := authCoins.IsAllGTE(vmCoins) && vmCoins.IsAllGTE(authCoins)
+
+	return BalanceMismatch{
+		Address:   addr,
+		AuthCoins: authCoins,
+		VMCoins:   vmCoins,
+		Mismatch:  !equal,
+	}
+}
+
+// SupplyDiff returns total supply increase / decrease caused by the mismatch fix.
+// Supply is not changed if auth coins are used as a source (auth coins are accounted by the supply).
+func (m BalanceMismatch) SupplyDiff(useVMCoins bool) (increase, decrease sdk.Coins) {
+	increase, decrease = sdk.NewCoins(), sdk.NewCoins()
+	if !useVMCoins {
+		return
+	}
+
+	for _, coin := range m.VMCoins {
+		if diff := coin.Amount.Sub(m.AuthCoins.AmountOf(coin.Denom)); diff.IsPositive() {
+			increase = increase.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	for _, coin := range m.AuthCoins {
+		if diff := coin.Amount.Sub(m.VMCoins.AmountOf(coin.Denom)); diff.IsPositive() {
+			decrease = decrease.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return
+}
+
+// BalanceMismatches slice of BalanceMismatch objects.
+type BalanceMismatches []BalanceMismatch
+
+func (list BalanceMismatches) String() string {
+	var strBuilder strings.Builder
+	strBuilder.WriteString("BalanceMismatches:\n")
+	for i, m := range list {
+		strBuilder.WriteString(m.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// SupplyKeeper defines the expected supply keeper (noalias)
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyExported.SupplyI
+	SetSupply(ctx sdk.Context, supply supplyExported.SupplyI)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryBalanceMismatch = "balance_mismatch"
+)
+
+// Client request for account balance mismatch.
+type BalanceMismatchReq struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
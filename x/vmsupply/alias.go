@@ -0,0 +1,22 @@
+package vmsupply
+
+import (
+	"github.com/dfinance/dnode/x/vmsupply/internal/keeper"
+	"github.com/dfinance/dnode/x/vmsupply/internal/types"
+)
+
+type (
+	Keeper = keeper.VMSupplyKeeper
+)
+
+const (
+	ModuleName       = types.ModuleName
+	SupplyModuleName = types.SupplyModuleName
+)
+
+var (
+	// function aliases
+	NewKeeper = keeper.NewKeeper
+	// perms requests
+	RequestCCStoragePerms = types.RequestCCStoragePerms
+)
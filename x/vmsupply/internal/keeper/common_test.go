@@ -0,0 +1,111 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/dfinance/dnode/helpers/tests"
+	"github.com/dfinance/dnode/x/ccstorage"
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm"
+	"github.com/dfinance/dnode/x/vmsupply/internal/types"
+)
+
+const (
+	minterModuleName = "minter"
+)
+
+type TestInput struct {
+	cdc *codec.Codec
+	ctx sdk.Context
+	//
+	keyParams  *sdk.KVStoreKey
+	keyVMS     *sdk.KVStoreKey
+	keyCCS     *sdk.KVStoreKey
+	keyAccount *sdk.KVStoreKey
+	keySupply  *sdk.KVStoreKey
+	tkeyParams *sdk.TransientStoreKey
+	//
+	paramsKeeper  params.Keeper
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.BaseKeeper
+	ccsStorage    ccstorage.Keeper
+	supplyKeeper  VMSupplyKeeper
+	//
+	vmStorage common_vm.VMStorage
+}
+
+func NewTestInput(t *testing.T) TestInput {
+	input := TestInput{
+		cdc:        codec.New(),
+		keyParams:  sdk.NewKVStoreKey(params.StoreKey),
+		keyVMS:     sdk.NewKVStoreKey(vm.StoreKey),
+		keyCCS:     sdk.NewKVStoreKey(ccstorage.StoreKey),
+		keyAccount: sdk.NewKVStoreKey(auth.StoreKey),
+		keySupply:  sdk.NewKVStoreKey(supply.StoreKey),
+		tkeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
+	}
+
+	// register codec
+	auth.RegisterCodec(input.cdc)
+	supply.RegisterCodec(input.cdc)
+	sdk.RegisterCodec(input.cdc)
+	codec.RegisterCrypto(input.cdc)
+
+	// init in-memory DB
+	db := dbm.NewMemDB()
+	mstore := store.NewCommitMultiStore(db)
+	mstore.MountStoreWithDB(input.keyParams, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyVMS, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyCCS, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyAccount, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keySupply, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.tkeyParams, sdk.StoreTypeTransient, db)
+	err := mstore.LoadLatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// create test VM storage
+	input.vmStorage = tests.NewVMStorage(input.keyVMS)
+
+	// create target and dependant keepers
+	maccPerms := map[string][]string{
+		minterModuleName: {supply.Minter, supply.Burner},
+	}
+
+	input.paramsKeeper = params.NewKeeper(input.cdc, input.keyParams, input.tkeyParams)
+	input.ccsStorage = ccstorage.NewKeeper(
+		input.cdc,
+		input.keyCCS,
+		input.vmStorage,
+		types.RequestCCStoragePerms(),
+	)
+	input.accountKeeper = auth.NewAccountKeeper(input.cdc, input.keyAccount, input.paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	input.bankKeeper = bank.NewBaseKeeper(input.accountKeeper, input.paramsKeeper.Subspace(bank.DefaultParamspace), make(map[string]bool))
+	input.supplyKeeper = NewKeeper(
+		supply.NewKeeper(input.cdc, input.keySupply, input.accountKeeper, input.bankKeeper, maccPerms),
+		input.ccsStorage,
+	)
+
+	// create context
+	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+
+	// init genesis
+	input.ccsStorage.InitDefaultGenesis(input.ctx)
+	input.supplyKeeper.SetSupply(input.ctx, supply.NewSupply(sdk.NewCoins()))
+
+	return input
+}
@@ -0,0 +1,132 @@
+// VM supply module keeper implements supply keeper with additional ccstorage / VM resources supply handling.
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/dfinance/dnode/x/ccstorage"
+)
+
+// Module keeper object.
+type VMSupplyKeeper struct {
+	supply.Keeper
+
+	ccsKeeper ccstorage.Keeper
+}
+
+// SetSupply sets supply to the std keeper and updates currencies supplies (ccstorage and VM CurrencyInfo resources).
+// All registered currencies are synced on the initial SetSupply call (genesis init).
+func (k VMSupplyKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	prevTotal, prevFound := k.getPrevTotalSupply(ctx)
+	k.Keeper.SetSupply(ctx, supply)
+	k.syncCurrencies(ctx, prevTotal, !prevFound)
+}
+
+// MintCoins creates new coins via the std keeper and updates currencies supplies.
+// Std keeper calls its own SetSupply, so the override is required.
+func (k VMSupplyKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	prevTotal := k.Keeper.GetSupply(ctx).GetTotal()
+	if err := k.Keeper.MintCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.syncCurrencies(ctx, prevTotal, false)
+
+	return nil
+}
+
+// BurnCoins burns coins via the std keeper and updates currencies supplies.
+// Std keeper calls its own SetSupply, so the override is required.
+func (k VMSupplyKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	prevTotal := k.Keeper.GetSupply(ctx).GetTotal()
+	if err := k.Keeper.BurnCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.syncCurrencies(ctx, prevTotal, false)
+
+	return nil
+}
+
+// syncCurrencies sets registered currencies supplies equal to the std keeper total supply.
+// Only denoms with total supply changed (compared to {prevTotal}) are synced, unless {syncAll} is set.
+// Supply denoms not registered in ccstorage are skipped (covered by currencies module invariants).
+// VM-native tokens are skipped as their supply is managed by VM.
+func (k VMSupplyKeeper) syncCurrencies(ctx sdk.Context, prevTotal sdk.Coins, syncAll bool) {
+	total := k.Keeper.GetSupply(ctx).GetTotal()
+
+	var denoms []string
+	if syncAll {
+		for _, currency := range k.ccsKeeper.GetCurrencies(ctx) {
+			denoms = append(denoms, currency.Denom)
+		}
+	} else {
+		denoms = getChangedDenoms(prevTotal, total)
+	}
+
+	for _, denom := range denoms {
+		if !k.ccsKeeper.HasCurrency(ctx, denom) {
+			continue
+		}
+
+		currency, err := k.ccsKeeper.GetCurrency(ctx, denom)
+		if err != nil {
+			panic(fmt.Errorf("syncing currency %q supply: %v", denom, err))
+		}
+		if currency.IsToken() {
+			continue
+		}
+
+		targetAmount := total.AmountOf(denom)
+
+		switch {
+		case targetAmount.GT(currency.Supply):
+			err = k.ccsKeeper.IncreaseCurrencySupply(ctx, sdk.NewCoin(denom, targetAmount.Sub(currency.Supply)))
+		case targetAmount.LT(currency.Supply):
+			err = k.ccsKeeper.DecreaseCurrencySupply(ctx, sdk.NewCoin(denom, currency.Supply.Sub(targetAmount)))
+		}
+		if err != nil {
+			panic(fmt.Errorf("syncing currency %q supply: %v", denom, err))
+		}
+	}
+}
+
+// getPrevTotalSupply returns the std keeper total supply ({found} is false if supply is not set yet: genesis init).
+func (k VMSupplyKeeper) getPrevTotalSupply(ctx sdk.Context) (total sdk.Coins, found bool) {
+	// std keeper panics if supply is not set
+	defer func() {
+		if r := recover(); r != nil {
+			total, found = nil, false
+		}
+	}()
+
+	return k.Keeper.GetSupply(ctx).GetTotal(), true
+}
+
+// getChangedDenoms returns denoms with different {prev} and {cur} amounts.
+func getChangedDenoms(prev, cur sdk.Coins) []string {
+	denoms := make([]string, 0)
+	for _, coin := range cur {
+		if !coin.Amount.Equal(prev.AmountOf(coin.Denom)) {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+
+	for _, coin := range prev {
+		if !coin.Amount.IsZero() && cur.AmountOf(coin.Denom).IsZero() {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+
+	return denoms
+}
+
+// Create new keeper wrapping the std supply keeper.
+func NewKeeper(supplyKeeper supply.Keeper, ccsKeeper ccstorage.Keeper) VMSupplyKeeper {
+	return VMSupplyKeeper{
+		Keeper:    supplyKeeper,
+		ccsKeeper: ccsKeeper,
+	}
+}
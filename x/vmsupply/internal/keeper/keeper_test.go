@@ -0,0 +1,77 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+)
+
+// Test currency supply and VM CurrencyInfo resource are updated on supply change.
+func TestVMSupplyKeeper_SupplySync(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ccsStorage, ctx := input.supplyKeeper, input.ccsStorage, input.ctx
+
+	checkSupply := func(t *testing.T, denom string, amount sdk.Int) {
+		require.True(t, keeper.GetSupply(ctx).GetTotal().AmountOf(denom).Equal(amount), "supply")
+
+		currency, err := ccsStorage.GetCurrency(ctx, denom)
+		require.NoError(t, err)
+		require.True(t, currency.Supply.Equal(amount), "ccStorage.Supply")
+
+		curInfo, err := ccsStorage.GetResStdCurrencyInfo(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, amount.String(), curInfo.TotalSupply.String(), "VM.CurrencyInfo.TotalSupply")
+	}
+
+	// ok: SetSupply
+	{
+		keeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(100)))))
+		checkSupply(t, "xfi", sdk.NewInt(100))
+
+		keeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(50)))))
+		checkSupply(t, "xfi", sdk.NewInt(50))
+	}
+
+	// ok: MintCoins
+	{
+		require.NoError(t, keeper.MintCoins(ctx, minterModuleName, sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(25)))))
+		checkSupply(t, "xfi", sdk.NewInt(75))
+	}
+
+	// ok: BurnCoins
+	{
+		require.NoError(t, keeper.BurnCoins(ctx, minterModuleName, sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(10)))))
+		checkSupply(t, "xfi", sdk.NewInt(65))
+	}
+
+	// ok: only changed denoms are synced
+	{
+		require.NoError(t, ccsStorage.IncreaseCurrencySupply(ctx, sdk.NewCoin("btc", sdk.NewInt(1))))
+
+		require.NoError(t, keeper.MintCoins(ctx, minterModuleName, sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(10)))))
+		checkSupply(t, "xfi", sdk.NewInt(75))
+
+		currency, err := ccsStorage.GetCurrency(ctx, "btc")
+		require.NoError(t, err)
+		require.True(t, currency.Supply.Equal(sdk.NewInt(1)), "ccStorage.Supply")
+
+		keeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(65)), sdk.NewCoin("btc", sdk.NewInt(2)))))
+		checkSupply(t, "xfi", sdk.NewInt(65))
+		checkSupply(t, "btc", sdk.NewInt(2))
+
+		keeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(65)))))
+		checkSupply(t, "btc", sdk.ZeroInt())
+	}
+
+	// ok: unregistered denom is skipped
+	{
+		keeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin("xfi", sdk.NewInt(65)), sdk.NewCoin("unknown", sdk.NewInt(1)))))
+		checkSupply(t, "xfi", sdk.NewInt(65))
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+import supplyTypes "github.com/cosmos/cosmos-sdk/x/supply"
+
+const (
+	// Module name (matches the package path, as used by perms caller checks)
+	ModuleName = "vmsupply"
+	// Wrapped supply module name
+	SupplyModuleName = supplyTypes.ModuleName
+)